@@ -0,0 +1,201 @@
+package textprompts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"testing/fstest"
+)
+
+// archiveOpeners maps the path suffixes resolvePath recognizes as archives
+// to a function that opens the archive's contents as an FS, so it can be
+// walked with the same glob/recursion semantics as a real directory via
+// resolvePathFS. Checked in order with strings.HasSuffix; no entry's suffix
+// is itself a suffix of another's (".tar" does not end "pack.tar.gz"), so
+// the order does not change which opener is picked.
+var archiveOpeners = []struct {
+	suffix string
+	open   func(path string) (FS, error)
+}{
+	{".zip", openZipFS},
+	{".tar.gz", openTarFS(gzipDecompress)},
+	{".tar.bz2", openTarFS(bzip2Decompress)},
+	{".tar", openTarFS(nil)},
+}
+
+// isArchivePath reports whether path has an extension resolvePath knows how
+// to open as a virtual directory of prompts.
+func isArchivePath(p string) bool {
+	for _, a := range archiveOpeners {
+		if strings.HasSuffix(p, a.suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// openArchive opens path as a read-only FS of its contents, dispatched by
+// file extension the same way generic VFS openers do.
+func openArchive(path string) (FS, error) {
+	for _, a := range archiveOpeners {
+		if strings.HasSuffix(path, a.suffix) {
+			return a.open(path)
+		}
+	}
+
+	return nil, fmt.Errorf("textprompts: unsupported archive extension: %s", path)
+}
+
+// archiveEntrySep separates an archive path from an entry path within it,
+// e.g. "pack.zip!prompts/greeting.txt". This mirrors the convention used by
+// other Go tools (e.g. go/build's zip-backed GOROOT) for addressing a file
+// inside an archive with a single string.
+const archiveEntrySep = "!"
+
+// archiveEntryPath builds the combined identifier for an entry inside an
+// archive, used as the element of resolvePath's returned file list.
+func archiveEntryPath(archivePath, entryPath string) string {
+	return archivePath + archiveEntrySep + entryPath
+}
+
+// splitArchiveEntryPath reverses archiveEntryPath, reporting ok=false if p
+// doesn't look like an archive-entry identifier.
+func splitArchiveEntryPath(p string) (archivePath, entryPath string, ok bool) {
+	idx := strings.Index(p, archiveEntrySep)
+	if idx < 0 {
+		return "", "", false
+	}
+
+	archivePath, entryPath = p[:idx], p[idx+1:]
+	if !isArchivePath(archivePath) {
+		return "", "", false
+	}
+
+	return archivePath, entryPath, true
+}
+
+// resolveArchivePath opens path as an archive and resolves it as a virtual
+// directory of prompts, honoring the same WithRecursive/WithGlob options
+// findFilesInDir applies to a real directory.
+func resolveArchivePath(path string, options *loadOptions) ([]string, error) {
+	fsys, err := openArchive(path)
+	if err != nil {
+		return nil, &TextPromptsError{
+			Message: "failed to open archive",
+			Cause:   err,
+		}
+	}
+
+	matches, err := resolvePathFS(fsys, ".", options)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]string, 0, len(matches))
+	for _, m := range matches {
+		entries = append(entries, archiveEntryPath(path, m))
+	}
+
+	return entries, nil
+}
+
+// openZipFS reads path as a zip archive into memory and returns an FS over
+// its entries.
+func openZipFS(path string) (FS, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+
+	files := make(fstest.MapFS)
+
+	for _, zf := range r.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", zf.Name, err)
+		}
+
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", zf.Name, err)
+		}
+
+		files[normalizeArchiveEntryName(zf.Name)] = &fstest.MapFile{Data: data, Mode: zf.Mode()}
+	}
+
+	return files, nil
+}
+
+// openTarFS returns an opener for tar archives, optionally wrapping the
+// underlying reader with decompress first (for .tar.gz/.tar.bz2). A nil
+// decompress reads a plain, uncompressed .tar.
+func openTarFS(decompress func(io.Reader) (io.Reader, error)) func(string) (FS, error) {
+	return func(path string) (FS, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+
+		r := io.Reader(f)
+		if decompress != nil {
+			r, err = decompress(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		files := make(fstest.MapFS)
+		tr := tar.NewReader(r)
+
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag != tar.TypeReg {
+				continue
+			}
+
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %w", hdr.Name, err)
+			}
+
+			files[normalizeArchiveEntryName(hdr.Name)] = &fstest.MapFile{Data: data, Mode: fs.FileMode(hdr.Mode)} //nolint:gosec // archive-controlled mode bits, not used for access control
+		}
+
+		return files, nil
+	}
+}
+
+func gzipDecompress(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+func bzip2Decompress(r io.Reader) (io.Reader, error) {
+	return bzip2.NewReader(r), nil
+}
+
+// normalizeArchiveEntryName normalizes an archive entry name into the
+// slash-separated, cleaned form fs.FS implementations require.
+func normalizeArchiveEntryName(name string) string {
+	return path.Clean(strings.TrimPrefix(strings.ReplaceAll(name, "\\", "/"), "/"))
+}
@@ -0,0 +1,141 @@
+package textprompts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestZip(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+
+	zipPath := filepath.Join(dir, "pack.zip")
+	f, err := os.Create(zipPath)
+	if err != nil {
+		t.Fatalf("failed to create zip: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("failed to add %s to zip: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to close zip writer: %v", err)
+	}
+
+	return zipPath
+}
+
+func writeTestTarGz(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+
+	tarPath := filepath.Join(dir, "pack.tar.gz")
+	f, err := os.Create(tarPath)
+	if err != nil {
+		t.Fatalf("failed to create tar.gz: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("failed to write header for %s: %v", name, err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+
+	return tarPath
+}
+
+func TestLoadPromptsFromZip(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-archive-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	zipPath := writeTestZip(t, tmpDir, map[string]string{
+		"greeting.txt":     "Hello, {name}!",
+		"farewell.txt":     "Bye, {name}!",
+		"nested/other.txt": "Nested, {name}!",
+		"readme.md":        "not a prompt",
+	})
+
+	prompts, err := LoadPrompts([]string{zipPath})
+	if err != nil {
+		t.Fatalf("LoadPrompts() from zip error = %v", err)
+	}
+	if len(prompts) != 2 {
+		t.Errorf("LoadPrompts() from zip returned %d prompts, want 2 (top-level only, non-recursive default)", len(prompts))
+	}
+
+	recursive, err := LoadPrompts([]string{zipPath}, WithRecursive())
+	if err != nil {
+		t.Fatalf("LoadPrompts() from zip with WithRecursive error = %v", err)
+	}
+	if len(recursive) != 3 {
+		t.Errorf("LoadPrompts() from zip with WithRecursive returned %d prompts, want 3", len(recursive))
+	}
+}
+
+func TestLoadPromptsFromTarGz(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-archive-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	tarPath := writeTestTarGz(t, tmpDir, map[string]string{
+		"greeting.txt": "Hello, {name}!",
+	})
+
+	prompts, err := LoadPrompts([]string{tarPath})
+	if err != nil {
+		t.Fatalf("LoadPrompts() from tar.gz error = %v", err)
+	}
+	if len(prompts) != 1 {
+		t.Fatalf("LoadPrompts() from tar.gz returned %d prompts, want 1", len(prompts))
+	}
+	if prompts[0].Prompt.String() != "Hello, {name}!" {
+		t.Errorf("prompt body = %q, want %q", prompts[0].Prompt.String(), "Hello, {name}!")
+	}
+}
+
+func TestIsArchivePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"pack.zip", true},
+		{"pack.tar", true},
+		{"pack.tar.gz", true},
+		{"pack.tar.bz2", true},
+		{"prompts/", false},
+		{"greeting.txt", false},
+	}
+	for _, tt := range tests {
+		if got := isArchivePath(tt.path); got != tt.want {
+			t.Errorf("isArchivePath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
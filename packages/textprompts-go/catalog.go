@@ -0,0 +1,166 @@
+package textprompts
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// localeSuffixPattern matches the filename-suffix form of a BCP-47 locale
+// tag we recognize: a 2-3 letter language code with an optional 2-letter
+// region or 4-letter script, e.g. "fr", "en-US", "zh-Hans". This is
+// deliberately narrower than full BCP-47 so that ordinary dotted filenames
+// (e.g. "my.prompt.txt") are never mistaken for a locale suffix.
+var localeSuffixPattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z]{2}|-[a-zA-Z]{4})?$`)
+
+// Catalog groups prompts by base name and BCP-47 locale, modeled on how
+// golang.org/x/text/message resolves translated messages. Files are matched
+// by stripping an optional "<lang>[-<region>]" suffix from the filename:
+// "greeting.en-US.txt" and "greeting.fr.txt" are locale variants of the base
+// name "greeting", and "greeting.txt" is the default (no-locale) variant.
+type Catalog struct {
+	// entries maps base name -> locale tag string (BCP-47, "" for default) -> prompt.
+	entries map[string]map[string]*Prompt
+}
+
+// LoadCatalog resolves root with resolvePath (a directory, glob pattern, or
+// single file, honoring WithRecursive/WithGlob as LoadPrompts does) and
+// builds a Catalog from every matching file. Each file is read from the OS
+// filesystem with parseFile; archive entries and remote URLs are not loaded.
+func LoadCatalog(root string, opts ...LoadOption) (*Catalog, error) {
+	options := defaultLoadOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	mode := resolveMode(options)
+	warnHandler := resolveWarnHandler(options)
+
+	files, err := resolvePath(root, options)
+	if err != nil {
+		return nil, err
+	}
+
+	cat := &Catalog{entries: make(map[string]map[string]*Prompt)}
+
+	for _, file := range files {
+		base, tag := parseLocalizedFilename(file)
+
+		prompt, err := parseFile(file, mode, warnHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		// Frontmatter locale overrides the filename-derived tag.
+		if locale := prompt.Meta.GetLocale(); locale != "" {
+			parsed, parseErr := language.Parse(locale)
+			if parseErr != nil {
+				if mode == ModeStrict {
+					return nil, NewInvalidMetadataError(file, fmt.Sprintf("invalid locale %q", locale), parseErr)
+				}
+			} else {
+				tag = parsed.String()
+			}
+		}
+
+		if cat.entries[base] == nil {
+			cat.entries[base] = make(map[string]*Prompt)
+		}
+		cat.entries[base][tag] = prompt
+	}
+
+	return cat, nil
+}
+
+// Get resolves name for tag via a BCP-47 fallback chain: exact match, then
+// language-only match, then the default (no-locale) variant.
+func (c *Catalog) Get(name string, tag language.Tag) (*Prompt, error) {
+	variants, ok := c.entries[name]
+	if !ok {
+		return nil, NewFileMissingError(name, nil)
+	}
+
+	for _, candidate := range localeFallbackChain(tag) {
+		if p, ok := variants[candidate]; ok {
+			return p, nil
+		}
+	}
+
+	if p, ok := variants[""]; ok {
+		return p, nil
+	}
+
+	return nil, NewFileMissingError(name, nil)
+}
+
+// Tags lists the locale tags available for name ("" denotes the default,
+// no-locale variant). Returns nil if name is not in the catalog.
+func (c *Catalog) Tags(name string) []string {
+	variants, ok := c.entries[name]
+	if !ok {
+		return nil
+	}
+
+	tags := make([]string, 0, len(variants))
+	for tag := range variants {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// localeFallbackChain returns tag's string form followed by its base
+// language, in resolution order (e.g. "fr-FR" then "fr").
+func localeFallbackChain(tag language.Tag) []string {
+	chain := []string{tag.String()}
+
+	base, conf := tag.Base()
+	if conf != language.No && base.String() != tag.String() {
+		chain = append(chain, base.String())
+	}
+
+	return chain
+}
+
+// parseLocalizedFilename splits a prompt filename into its base name and
+// locale tag (the empty string when no locale suffix is present), e.g.
+// "greeting.en-US.txt" -> ("greeting", "en-US"), "greeting.txt" -> ("greeting", "").
+func parseLocalizedFilename(path string) (base, tag string) {
+	name := filepath.Base(path)
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+
+	idx := strings.LastIndex(stem, ".")
+	if idx == -1 {
+		return stem, ""
+	}
+
+	candidate := stem[idx+1:]
+	if localeSuffixPattern.MatchString(candidate) {
+		if parsed, err := language.Parse(candidate); err == nil {
+			return stem[:idx], parsed.String()
+		}
+	}
+
+	return stem, ""
+}
+
+// resolveLocalizedPath returns the sibling of path closest to tag in the
+// locale fallback chain, or path itself if no variant exists on disk.
+func resolveLocalizedPath(path string, tag language.Tag) string {
+	dir := filepath.Dir(path)
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(filepath.Base(path), ext)
+
+	for _, candidate := range localeFallbackChain(tag) {
+		variant := filepath.Join(dir, base+"."+candidate+ext)
+		if fileExists(variant) {
+			return variant
+		}
+	}
+
+	return path
+}
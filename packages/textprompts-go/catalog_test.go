@@ -0,0 +1,108 @@
+package textprompts
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseLocalizedFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		wantBase string
+		wantTag  string
+	}{
+		{"no locale", "testdata/catalog/greeting.txt", "greeting", ""},
+		{"language only", "dir/greeting.fr.txt", "greeting", "fr"},
+		{"language and region", "dir/greeting.en-US.txt", "greeting", "en-US"},
+		{"non-locale middle segment", "dir/my.prompt.txt", "my.prompt", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, tag := parseLocalizedFilename(tt.path)
+			if base != tt.wantBase || tag != tt.wantTag {
+				t.Errorf("parseLocalizedFilename(%q) = (%q, %q), want (%q, %q)", tt.path, base, tag, tt.wantBase, tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestLoadCatalogAndGet(t *testing.T) {
+	cat, err := LoadCatalog("testdata/catalog")
+	if err != nil {
+		t.Fatalf("LoadCatalog() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		tag       language.Tag
+		wantTitle string
+	}{
+		{"exact region match", language.MustParse("en-US"), "Greeting en-US"},
+		{"language-only fallback", language.MustParse("fr-FR"), "Greeting FR"},
+		{"default fallback", language.German, "Greeting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := cat.Get("greeting", tt.tag)
+			if err != nil {
+				t.Fatalf("Catalog.Get() error = %v", err)
+			}
+			if got := p.Meta.GetTitle(); got != tt.wantTitle {
+				t.Errorf("Catalog.Get() title = %q, want %q", got, tt.wantTitle)
+			}
+		})
+	}
+
+	t.Run("unknown name", func(t *testing.T) {
+		if _, err := cat.Get("does-not-exist", language.English); err == nil {
+			t.Error("Catalog.Get() error = nil, want error for unknown name")
+		}
+	})
+}
+
+func TestCatalogTags(t *testing.T) {
+	cat, err := LoadCatalog("testdata/catalog")
+	if err != nil {
+		t.Fatalf("LoadCatalog() error = %v", err)
+	}
+
+	tags := cat.Tags("greeting")
+	sort.Strings(tags)
+
+	want := []string{"", "en-US", "fr"}
+	if len(tags) != len(want) {
+		t.Fatalf("Catalog.Tags() = %v, want %v", tags, want)
+	}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Errorf("Catalog.Tags()[%d] = %q, want %q", i, tags[i], want[i])
+		}
+	}
+
+	if tags := cat.Tags("does-not-exist"); tags != nil {
+		t.Errorf("Catalog.Tags() = %v, want nil", tags)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	p, err := LoadPrompt("testdata/catalog/greeting.txt", WithLocale(language.French))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if got := p.Meta.GetTitle(); got != "Greeting FR" {
+		t.Errorf("LoadPrompt() with WithLocale(fr) title = %q, want %q", got, "Greeting FR")
+	}
+
+	p, err = LoadPrompt("testdata/catalog/greeting.txt", WithLocale(language.German))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if got := p.Meta.GetTitle(); got != "Greeting" {
+		t.Errorf("LoadPrompt() with WithLocale(de) title = %q, want %q", got, "Greeting")
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+var (
+	listRecursive bool
+	listGlob      string
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list <dir>",
+	Short: "List prompt files in a directory with their title, version, and placeholders",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := []textprompts.LoadOption{textprompts.WithGlob(listGlob)}
+		if listRecursive {
+			opts = append(opts, textprompts.WithRecursive())
+		}
+
+		prompts, err := textprompts.LoadPrompts([]string{args[0]}, opts...)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "PATH\tTITLE\tVERSION\tPLACEHOLDERS")
+		for _, p := range prompts {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				p.Path, p.Meta.GetTitle(), p.Meta.GetVersion(), strings.Join(p.Prompt.Placeholders(), ", "))
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	listCmd.Flags().BoolVar(&listRecursive, "recursive", false, "recurse into subdirectories")
+	listCmd.Flags().StringVar(&listGlob, "glob", "*.txt", "glob pattern for matching files")
+	rootCmd.AddCommand(listCmd)
+}
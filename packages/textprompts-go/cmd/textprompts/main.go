@@ -0,0 +1,15 @@
+// Command textprompts lists, validates, and renders textprompts prompt
+// files from the shell, primarily for use as a CI check.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+var placeholdersCmd = &cobra.Command{
+	Use:   "placeholders <file>",
+	Short: "Print the placeholders referenced by a prompt file as a JSON array",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		prompt, err := textprompts.LoadPrompt(args[0])
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(prompt.Prompt.Placeholders())
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(placeholdersCmd)
+}
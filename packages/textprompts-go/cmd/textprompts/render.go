@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+var (
+	renderVars           []string
+	renderJSONVarsPath   string
+	renderSkipValidation bool
+)
+
+var renderCmd = &cobra.Command{
+	Use:   "render <file>",
+	Short: "Render a prompt file with the given variables and print it to stdout",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values := make(map[string]interface{})
+
+		if renderJSONVarsPath != "" {
+			data, err := os.ReadFile(renderJSONVarsPath)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(data, &values); err != nil {
+				return fmt.Errorf("parsing %s: %w", renderJSONVarsPath, err)
+			}
+		}
+
+		for _, kv := range renderVars {
+			name, value, ok := strings.Cut(kv, "=")
+			if !ok {
+				return fmt.Errorf("invalid --var %q, expected name=value", kv)
+			}
+			values[name] = value
+		}
+
+		prompt, err := textprompts.LoadPrompt(args[0])
+		if err != nil {
+			return err
+		}
+
+		var opts []textprompts.FormatOption
+		if renderSkipValidation {
+			opts = append(opts, textprompts.WithSkipValidation())
+		}
+
+		result, err := prompt.Format(values, opts...)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), result)
+
+		return nil
+	},
+}
+
+func init() {
+	renderCmd.Flags().StringArrayVar(&renderVars, "var", nil, "placeholder value as name=value (repeatable)")
+	renderCmd.Flags().StringVar(&renderJSONVarsPath, "json-vars", "", "path to a JSON file of placeholder values")
+	renderCmd.Flags().BoolVar(&renderSkipValidation, "skip-validation", false,
+		"allow partial rendering without supplying every placeholder")
+	rootCmd.AddCommand(renderCmd)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+var (
+	metadataMode      string
+	noWarnIgnoredMeta bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "textprompts",
+	Short: "Inspect, validate, and render textprompts prompt files",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if metadataMode != "" {
+			mode, err := textprompts.ParseMetadataMode(metadataMode)
+			if err != nil {
+				return err
+			}
+			textprompts.SetMetadata(mode)
+		}
+		if noWarnIgnoredMeta {
+			textprompts.SetWarnOnIgnoredMetadata(false)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&metadataMode, "metadata-mode", "",
+		`metadata mode override: "allow", "strict", or "ignore"`)
+	rootCmd.PersistentFlags().BoolVar(&noWarnIgnoredMeta, "no-warn-ignored-metadata", false,
+		"suppress warnings emitted for ignored metadata")
+}
+
+// Execute runs the root command with the process's command-line arguments.
+func Execute() error {
+	return rootCmd.Execute()
+}
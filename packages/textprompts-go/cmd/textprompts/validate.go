@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+var validateMode string
+
+var validateCmd = &cobra.Command{
+	Use:   "validate <dir>",
+	Short: "Validate that every prompt file in a directory parses cleanly",
+	Long: `Validate walks <dir> recursively with LoadPrompts and exits non-zero if
+any file fails to parse or is missing required metadata, making it suitable
+as a CI step.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mode := textprompts.GetMetadata()
+		if validateMode != "" {
+			m, err := textprompts.ParseMetadataMode(validateMode)
+			if err != nil {
+				return err
+			}
+			mode = m
+		}
+
+		prompts, err := textprompts.LoadPrompts([]string{args[0]},
+			textprompts.WithRecursive(),
+			textprompts.WithMetadataMode(mode),
+		)
+		if err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "%d prompt(s) OK\n", len(prompts))
+
+		return nil
+	},
+}
+
+func init() {
+	validateCmd.Flags().StringVar(&validateMode, "mode", "",
+		`metadata mode override for this run: "allow", "strict", or "ignore"`)
+	rootCmd.AddCommand(validateCmd)
+}
@@ -75,6 +75,103 @@ PromptString validates that all placeholders have values:
 	)
 	// partial = "Hello Alice, you are {role}"
 
+# Loading From Virtual Filesystems
+
+LoadPromptFS and LoadPromptsFS accept any io/fs.FS, so prompts can ship
+inside a binary via //go:embed, be read from an in-memory filesystem in
+tests, or come from any other virtual filesystem:
+
+	//go:embed prompts/*.txt
+	var promptFS embed.FS
+
+	prompt, err := textprompts.LoadPromptFS(promptFS, "prompts/greeting.txt")
+
+WithFS gives LoadPrompt and LoadPrompts the same ability, so directory
+walking, globbing, and locale resolution all work against an fs.FS too:
+
+	prompts, err := textprompts.LoadPrompts([]string{"prompts"},
+		textprompts.WithFS(promptFS), textprompts.WithRecursive())
+
+# Saving
+
+SavePrompt and SavePromptContent write atomically by default: the content is
+written to a sibling temp file, fsynced, then renamed over the target, and a
+"<path>.lock" sidecar file guards the critical section against concurrent
+writers, in this process or another. Use WithAtomicWrite(false) to skip the
+temp file and rename (the lock is still taken) for performance-sensitive bulk
+imports:
+
+	err := textprompts.SavePrompt(path, prompt, textprompts.WithAtomicWrite(false))
+
+# Hot Reloading
+
+Registry watches a set of paths with fsnotify and reloads changed prompts in
+place, for long-running services that want to edit prompts without
+redeploying. A failed reload keeps serving the last-known-good prompt for
+that key and surfaces the error on the subscription channel. Reload()
+triggers an immediate refresh outside of Start's fsnotify loop:
+
+	reg, err := textprompts.NewRegistry([]string{"prompts/"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = reg.Start(ctx)
+
+	prompt, ok := reg.Get("summarize")
+
+If something else writes the watched files through SavePrompt/
+SavePromptContent while the Registry is running, WithFileLocking makes each
+reload wait on the same advisory lock the atomic Writer takes, so a reload
+racing a write never parses a half-written file:
+
+	reg, err := textprompts.NewRegistry([]string{"prompts/"}, textprompts.WithFileLocking())
+
+# Loading From Archives
+
+LoadPrompts recognizes paths ending in .zip, .tar, .tar.gz, or .tar.bz2 and
+opens them as a virtual directory of prompts, so a curated prompt pack can
+ship as a single downloadable artifact:
+
+	prompts, err := textprompts.LoadPrompts([]string{"pack.zip"},
+		textprompts.WithRecursive(), textprompts.WithGlob("*.txt"))
+
+# Remote Prompts
+
+LoadPrompt and LoadPrompts also accept source paths whose scheme a Fetcher is
+registered for: "http://" and "https://" URLs, "git+https://host/owner/repo/
+path/to/file@ref" and go-getter-style "git::https://host/owner/repo/path/to/
+file?ref=ref" URLs (both resolved to the forge's raw-content endpoint), and
+"file://" paths, fetched and cached on disk so a team can share a canonical
+prompt library over HTTP instead of vendoring copies:
+
+	prompt, err := textprompts.LoadPrompt(
+		"https://example.com/prompts/greeting.txt",
+		textprompts.WithCacheDir("/var/cache/textprompts"),
+		textprompts.WithCacheTTL(5*time.Minute))
+
+Concurrent loads of the same URL are coalesced so only one fetch hits the
+network; the rest wait for and share its result. Requests revalidate a
+cached copy (via If-None-Match, for the http/https/git Fetchers) whenever
+WithCacheTTL's window has elapsed, or on every load by default, so a 304
+response still avoids re-downloading the body; each cached entry also carries
+a checksum that is verified on read, so a truncated or tampered cache file is
+treated as a miss rather than served. RegisterFetcher adds support for
+another scheme (e.g. "s3") globally, and WithFetcher overrides one for a
+single load:
+
+	textprompts.RegisterFetcher("s3", myS3Fetcher)
+
+# Symlinks
+
+By default LoadPrompts follows symlinks while walking a directory (matching
+historical behavior, from before SymlinkPolicy existed), detecting and
+stopping at cycles, and fails the walk with a DanglingSymlinkError if a
+followed link points nowhere. Use WithSymlinks to skip symlinks entirely
+(dangling ones included) or to treat any symlink as an error, e.g. when
+loading from a shared or mounted directory:
+
+	prompts, err := textprompts.LoadPrompts([]string{"prompts/"},
+		textprompts.WithRecursive(), textprompts.WithSymlinks(textprompts.SymlinkSkip))
+
 # Bulk Loading
 
 Load multiple prompts from a directory:
@@ -85,6 +182,11 @@ Load multiple prompts from a directory:
 		textprompts.WithGlob("*.txt"),
 	)
 
+LoadPrompts deduplicates its result by file identity, not just by string
+path, so the same file reached twice (directly and via a directory it's in,
+or through a symlink) is only loaded once. Use WithAllowDuplicates to load
+it every time it's reached instead.
+
 # Error Handling
 
 The package provides specific error types:
@@ -101,6 +203,28 @@ Use the Is* helper functions or errors.As for type checking:
 		// Handle missing file
 	}
 
+InvalidMetadataError and MalformedHeaderError additionally carry a Location
+pinpointing where in the frontmatter the problem was found, so Error()
+reads as "path:line:col: message" when available:
+
+	var invalidErr *textprompts.InvalidMetadataError
+	if errors.As(err, &invalidErr) {
+		path, line, col := invalidErr.Location()
+	}
+
+# Warnings
+
+Loading can raise non-fatal warnings, such as metadata frontmatter present in
+a file loaded with ModeIgnore. By default these print a single line to
+os.Stderr; set a WarnHandler to route them to a structured logger instead, or
+use DiscardWarnings to silence them:
+
+	textprompts.SetWarnHandler(func(e textprompts.WarnEvent) {
+		log.Printf("textprompts: %s: %s", e.Severity, e.Message)
+	})
+
+WithWarnHandler overrides the handler for a single load.
+
 # Environment Variables
 
 Set TEXTPROMPTS_METADATA_MODE to configure the default mode:
@@ -4,18 +4,73 @@ package textprompts
 import (
 	"errors"
 	"fmt"
+	"io"
+	"runtime"
 	"strings"
 )
 
-// Error is the base error type for all textprompts errors.
+// Frame captures the source location where an error was constructed, so
+// %+v can print a short "file:line" alongside the plain message (similar to
+// how xerrors/fmt.Errorf with %w attach frame info).
+type Frame struct {
+	File string
+	Line int
+}
+
+// String returns "file:line", or "" if the frame was never captured.
+func (f Frame) String() string {
+	if f.File == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", f.File, f.Line)
+}
+
+// callerFrame captures the call site skip levels above its caller (skip=0
+// is the caller of callerFrame itself).
+func callerFrame(skip int) Frame {
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return Frame{}
+	}
+
+	return Frame{File: file, Line: line}
+}
+
+// SourceLocation identifies a specific line and column inside a source file,
+// for errors caused by invalid file content (as opposed to Frame, which
+// identifies where in the Go program the error was constructed). Col and
+// Excerpt are best-effort and may be zero/empty when the underlying parser
+// didn't report a column or the line couldn't be recovered.
+type SourceLocation struct {
+	Path    string
+	Line    int
+	Col     int
+	Excerpt string
+}
+
+// String returns "path:line:col", or "path:line" if Col is unset, or "" if
+// Line was never populated.
+func (l SourceLocation) String() string {
+	if l.Path == "" || l.Line == 0 {
+		return ""
+	}
+	if l.Col > 0 {
+		return fmt.Sprintf("%s:%d:%d", l.Path, l.Line, l.Col)
+	}
+	return fmt.Sprintf("%s:%d", l.Path, l.Line)
+}
+
+// TextPromptsError is the base error type for all textprompts errors.
 //
 //nolint:govet // Field layout is acceptable for this small error type.
-type Error struct {
+type TextPromptsError struct {
 	Message string
 	Cause   error
+	Frame   Frame
 }
 
-func (e *Error) Error() string {
+func (e *TextPromptsError) Error() string {
 	if e.Cause != nil {
 		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
 	}
@@ -23,13 +78,37 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-func (e *Error) Unwrap() error {
+func (e *TextPromptsError) Unwrap() error {
 	return e.Cause
 }
 
+// Format implements fmt.Formatter: %v and %s print the plain message, %+v
+// additionally appends the frame where the error was constructed.
+func (e *TextPromptsError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Frame)
+}
+
+// formatWithFrame is the shared fmt.Formatter implementation for every
+// error type in this file.
+func formatWithFrame(f fmt.State, verb rune, message string, frame Frame) {
+	switch verb {
+	case 'v':
+		io.WriteString(f, message) //nolint:errcheck // writing to fmt.State never fails
+		if f.Flag('+') {
+			if loc := frame.String(); loc != "" {
+				fmt.Fprintf(f, "\n\tat %s", loc)
+			}
+		}
+	case 's':
+		io.WriteString(f, message) //nolint:errcheck // writing to fmt.State never fails
+	case 'q':
+		fmt.Fprintf(f, "%q", message)
+	}
+}
+
 // FileMissingError indicates the specified file was not found.
 type FileMissingError struct {
-	Base Error
+	Base TextPromptsError
 	Path string
 }
 
@@ -41,12 +120,17 @@ func (e *FileMissingError) Unwrap() error {
 	return e.Base.Cause
 }
 
+func (e *FileMissingError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
 // NewFileMissingError creates a new FileMissingError.
 func NewFileMissingError(path string, cause error) *FileMissingError {
 	return &FileMissingError{
-		Base: Error{
+		Base: TextPromptsError{
 			Message: fmt.Sprintf("file not found: %s", path),
 			Cause:   cause,
+			Frame:   callerFrame(1),
 		},
 		Path: path,
 	}
@@ -54,7 +138,7 @@ func NewFileMissingError(path string, cause error) *FileMissingError {
 
 // MissingMetadataError indicates required metadata is missing in strict mode.
 type MissingMetadataError struct {
-	Base Error
+	Base TextPromptsError
 	Path string
 }
 
@@ -70,24 +154,37 @@ func (e *MissingMetadataError) Unwrap() error {
 	return e.Base.Cause
 }
 
+func (e *MissingMetadataError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
 // NewMissingMetadataError creates a new MissingMetadataError.
 func NewMissingMetadataError(path string) *MissingMetadataError {
 	return &MissingMetadataError{
-		Base: Error{
+		Base: TextPromptsError{
 			Message: fmt.Sprintf("missing required metadata in file: %s", path),
+			Frame:   callerFrame(1),
 		},
 		Path: path,
 	}
 }
 
-// InvalidMetadataError indicates malformed or invalid TOML metadata.
+// InvalidMetadataError indicates malformed or invalid TOML metadata. Loc is
+// populated when the failure can be traced to a specific line/column of the
+// frontmatter (e.g. a TOML syntax error reported by the decoder), so editors
+// and CI logs can jump straight to the broken line instead of just the file.
 type InvalidMetadataError struct {
-	Base   Error
+	Base   TextPromptsError
 	Path   string
 	Detail string
+	Loc    SourceLocation
 }
 
 func (e *InvalidMetadataError) Error() string {
+	if loc := e.Loc.String(); loc != "" {
+		return fmt.Sprintf("%s: %s", loc, e.Detail)
+	}
+
 	if e.Path != "" {
 		return fmt.Sprintf("invalid metadata in file %s: %s", e.Path, e.Detail)
 	}
@@ -99,25 +196,52 @@ func (e *InvalidMetadataError) Unwrap() error {
 	return e.Base.Cause
 }
 
-// NewInvalidMetadataError creates a new InvalidMetadataError.
+func (e *InvalidMetadataError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
+// Location returns the file path, 1-based line, and 1-based column where the
+// metadata error occurred, or ("", 0, 0) if no location was captured.
+func (e *InvalidMetadataError) Location() (path string, line, col int) {
+	return e.Loc.Path, e.Loc.Line, e.Loc.Col
+}
+
+// NewInvalidMetadataError creates a new InvalidMetadataError with no
+// specific source location.
 func NewInvalidMetadataError(path, detail string, cause error) *InvalidMetadataError {
 	return &InvalidMetadataError{
-		Base: Error{
+		Base: TextPromptsError{
 			Message: fmt.Sprintf("invalid metadata: %s", detail),
 			Cause:   cause,
+			Frame:   callerFrame(1),
 		},
 		Path:   path,
 		Detail: detail,
 	}
 }
 
-// MalformedHeaderError indicates the frontmatter structure is invalid.
+// NewInvalidMetadataErrorAt creates a new InvalidMetadataError pinned to loc,
+// the location inside path where decoding failed.
+func NewInvalidMetadataErrorAt(path, detail string, cause error, loc SourceLocation) *InvalidMetadataError {
+	err := NewInvalidMetadataError(path, detail, cause)
+	err.Loc = loc
+	return err
+}
+
+// MalformedHeaderError indicates the frontmatter structure is invalid. Loc
+// is populated when the failure can be traced to a specific line/column of
+// the frontmatter.
 type MalformedHeaderError struct {
-	Base Error
+	Base TextPromptsError
 	Path string
+	Loc  SourceLocation
 }
 
 func (e *MalformedHeaderError) Error() string {
+	if loc := e.Loc.String(); loc != "" {
+		return fmt.Sprintf("%s: malformed header", loc)
+	}
+
 	if e.Path != "" {
 		return fmt.Sprintf("malformed header in file: %s", e.Path)
 	}
@@ -129,42 +253,156 @@ func (e *MalformedHeaderError) Unwrap() error {
 	return e.Base.Cause
 }
 
-// NewMalformedHeaderError creates a new MalformedHeaderError.
+func (e *MalformedHeaderError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
+// Location returns the file path, 1-based line, and 1-based column where the
+// malformed header was detected, or ("", 0, 0) if no location was captured.
+func (e *MalformedHeaderError) Location() (path string, line, col int) {
+	return e.Loc.Path, e.Loc.Line, e.Loc.Col
+}
+
+// NewMalformedHeaderError creates a new MalformedHeaderError with no
+// specific source location.
 func NewMalformedHeaderError(path string) *MalformedHeaderError {
 	return &MalformedHeaderError{
-		Base: Error{
+		Base: TextPromptsError{
 			Message: fmt.Sprintf("malformed header in file: %s", path),
+			Frame:   callerFrame(1),
 		},
 		Path: path,
 	}
 }
 
-// FormatError indicates a placeholder formatting error.
+// NewMalformedHeaderErrorAt creates a new MalformedHeaderError pinned to loc,
+// the location inside path where the malformed header was detected.
+func NewMalformedHeaderErrorAt(path string, loc SourceLocation) *MalformedHeaderError {
+	err := NewMalformedHeaderError(path)
+	err.Loc = loc
+	return err
+}
+
+// FormatError indicates a placeholder formatting error: either required
+// values were missing, or a format spec (BadSpec) could not be applied. Path
+// is populated when the error originates from a *Prompt (as opposed to a
+// bare PromptString), so log lines can point at the offending file.
 type FormatError struct {
-	Base     Error
+	Base     TextPromptsError
 	Missing  []string
 	Provided []string
+	BadSpec  string
+	Path     string
 }
 
 func (e *FormatError) Error() string {
-	return fmt.Sprintf("missing format variables: [%s]", strings.Join(e.Missing, ", "))
+	var msg string
+	if e.BadSpec != "" {
+		msg = e.Base.Message
+	} else {
+		msg = fmt.Sprintf("missing format variables: [%s]", strings.Join(e.Missing, ", "))
+	}
+
+	if e.Path != "" {
+		return fmt.Sprintf("%s (in %s)", msg, e.Path)
+	}
+
+	return msg
 }
 
 func (e *FormatError) Unwrap() error {
 	return e.Base.Cause
 }
 
-// NewFormatError creates a new FormatError.
+func (e *FormatError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
+// NewFormatError creates a new FormatError for missing placeholder values.
 func NewFormatError(missing, provided []string) *FormatError {
 	return &FormatError{
-		Base: Error{
+		Base: TextPromptsError{
 			Message: fmt.Sprintf("missing format variables: [%s]", strings.Join(missing, ", ")),
+			Frame:   callerFrame(1),
 		},
 		Missing:  missing,
 		Provided: provided,
 	}
 }
 
+// NewFormatSpecError creates a FormatError for a placeholder whose format
+// spec could not be applied to the value supplied for it.
+func NewFormatSpecError(name, spec string, cause error) *FormatError {
+	return &FormatError{
+		Base: TextPromptsError{
+			Message: fmt.Sprintf("invalid format spec %q for placeholder %q: %v", spec, name, cause),
+			Cause:   cause,
+			Frame:   callerFrame(1),
+		},
+		BadSpec: spec,
+	}
+}
+
+// SymlinkError indicates a symlink was encountered under a directory being
+// walked with the SymlinkErrorPolicy policy (see WithSymlinks).
+type SymlinkError struct {
+	Base TextPromptsError
+	Path string
+}
+
+func (e *SymlinkError) Error() string {
+	return fmt.Sprintf("symlink encountered at %s", e.Path)
+}
+
+func (e *SymlinkError) Unwrap() error {
+	return e.Base.Cause
+}
+
+func (e *SymlinkError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
+// NewSymlinkError creates a new SymlinkError.
+func NewSymlinkError(path string) *SymlinkError {
+	return &SymlinkError{
+		Base: TextPromptsError{
+			Message: fmt.Sprintf("symlink encountered at %s", path),
+			Frame:   callerFrame(1),
+		},
+		Path: path,
+	}
+}
+
+// DanglingSymlinkError indicates a symlink was followed (SymlinkFollow, the
+// default policy; see WithSymlinks) to a target that doesn't exist.
+type DanglingSymlinkError struct {
+	Base TextPromptsError
+	Path string
+}
+
+func (e *DanglingSymlinkError) Error() string {
+	return fmt.Sprintf("dangling symlink at %s", e.Path)
+}
+
+func (e *DanglingSymlinkError) Unwrap() error {
+	return e.Base.Cause
+}
+
+func (e *DanglingSymlinkError) Format(f fmt.State, verb rune) {
+	formatWithFrame(f, verb, e.Error(), e.Base.Frame)
+}
+
+// NewDanglingSymlinkError creates a new DanglingSymlinkError.
+func NewDanglingSymlinkError(path string) *DanglingSymlinkError {
+	return &DanglingSymlinkError{
+		Base: TextPromptsError{
+			Message: fmt.Sprintf("dangling symlink at %s", path),
+			Frame:   callerFrame(1),
+		},
+		Path: path,
+	}
+}
+
 // Error type checking helpers
 
 // IsFileMissing checks if the error is a FileMissingError.
@@ -196,3 +434,15 @@ func IsFormatError(err error) bool {
 	var e *FormatError
 	return errors.As(err, &e)
 }
+
+// IsSymlinkError checks if the error is a SymlinkError.
+func IsSymlinkError(err error) bool {
+	var e *SymlinkError
+	return errors.As(err, &e)
+}
+
+// IsDanglingSymlink checks if the error is a DanglingSymlinkError.
+func IsDanglingSymlink(err error) bool {
+	var e *DanglingSymlinkError
+	return errors.As(err, &e)
+}
@@ -2,6 +2,8 @@ package textprompts
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -85,6 +87,35 @@ func TestMalformedHeaderError(t *testing.T) {
 	}
 }
 
+func TestInvalidMetadataErrorLocation(t *testing.T) {
+	loc := SourceLocation{Path: "/path/to/file.txt", Line: 3, Col: 5, Excerpt: `title = "unterminated`}
+	err := NewInvalidMetadataErrorAt("/path/to/file.txt", "bad TOML", errors.New("parse error"), loc)
+
+	expected := "/path/to/file.txt:3:5: bad TOML"
+	if got := err.Error(); got != expected {
+		t.Errorf("Error() = %q, want %q", got, expected)
+	}
+
+	path, line, col := err.Location()
+	if path != "/path/to/file.txt" || line != 3 || col != 5 {
+		t.Errorf("Location() = (%q, %d, %d), want (%q, 3, 5)", path, line, col, "/path/to/file.txt")
+	}
+}
+
+func TestSourceLocationString(t *testing.T) {
+	if got := (SourceLocation{}).String(); got != "" {
+		t.Errorf("String() = %q, want empty", got)
+	}
+
+	if got := (SourceLocation{Path: "f.txt", Line: 4}).String(); got != "f.txt:4" {
+		t.Errorf("String() = %q, want %q", got, "f.txt:4")
+	}
+
+	if got := (SourceLocation{Path: "f.txt", Line: 4, Col: 2}).String(); got != "f.txt:4:2" {
+		t.Errorf("String() = %q, want %q", got, "f.txt:4:2")
+	}
+}
+
 func TestFormatError(t *testing.T) {
 	err := NewFormatError([]string{"name", "status"}, []string{"age"})
 
@@ -165,3 +196,38 @@ func TestErrorWrapping(t *testing.T) {
 		t.Error("errors.Is() should find wrapped cause")
 	}
 }
+
+func TestErrorFormatPlusV(t *testing.T) {
+	err := NewFileMissingError("/path/to/file.txt", nil)
+
+	plain := fmt.Sprintf("%v", err)
+	if plain != err.Error() {
+		t.Errorf("%%v = %q, want %q", plain, err.Error())
+	}
+
+	detailed := fmt.Sprintf("%+v", err)
+	if !strings.HasPrefix(detailed, err.Error()) {
+		t.Errorf("%%+v = %q, want it to start with %q", detailed, err.Error())
+	}
+	if !strings.Contains(detailed, "errors_test.go") {
+		t.Errorf("%%+v = %q, want it to include the constructing frame", detailed)
+	}
+}
+
+func TestFormatErrorPath(t *testing.T) {
+	prompt := NewPromptWithTitle("greeting", "Hello {name}!")
+	prompt.Path = "/prompts/greeting.txt"
+
+	_, err := prompt.Format(map[string]interface{}{})
+
+	var fe *FormatError
+	if !errors.As(err, &fe) {
+		t.Fatalf("Format() error = %v, want *FormatError", err)
+	}
+	if fe.Path != prompt.Path {
+		t.Errorf("FormatError.Path = %q, want %q", fe.Path, prompt.Path)
+	}
+	if !strings.Contains(fe.Error(), prompt.Path) {
+		t.Errorf("FormatError.Error() = %q, want it to include path %q", fe.Error(), prompt.Path)
+	}
+}
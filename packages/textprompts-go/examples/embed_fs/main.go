@@ -0,0 +1,46 @@
+// Example: Loading prompts compiled into the binary with embed.FS
+package main
+
+import (
+	"embed"
+	"fmt"
+	"log"
+
+	"github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+//go:embed prompts/*.txt
+var promptFS embed.FS
+
+func main() {
+	fmt.Println("=== Load a Single Prompt via LoadPromptFS ===")
+	fmt.Println()
+
+	greeting, err := textprompts.LoadPromptFS(promptFS, "prompts/greeting.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := greeting.Format(map[string]interface{}{"name": "Alice"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(result)
+	fmt.Println()
+
+	fmt.Println("=== Load All Prompts via WithFS ===")
+	fmt.Println()
+
+	prompts, err := textprompts.LoadPrompts(
+		[]string{"prompts"},
+		textprompts.WithFS(promptFS),
+		textprompts.WithRecursive(),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, p := range prompts {
+		fmt.Printf("  - %s (%s)\n", p.Meta.GetTitle(), p.Path)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build unix
+
+package textprompts
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive, blocking advisory lock on f using flock(2).
+// It guards against other processes writing the same path concurrently; the
+// in-process sync.Mutex in pathMutex guards against other goroutines in this
+// binary, since flock is not visible to the Go race detector.
+func lockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,32 @@
+//go:build windows
+
+package textprompts
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockFile takes an exclusive, blocking advisory lock on f using
+// LockFileEx. See the unix implementation for why this is paired with an
+// in-process sync.Mutex.
+func lockFile(f *os.File) error {
+	return windows.LockFileEx(
+		windows.Handle(f.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK,
+		0,
+		1, 0,
+		new(windows.Overlapped),
+	)
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return windows.UnlockFileEx(
+		windows.Handle(f.Fd()),
+		0,
+		1, 0,
+		new(windows.Overlapped),
+	)
+}
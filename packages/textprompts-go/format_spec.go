@@ -0,0 +1,104 @@
+package textprompts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fmtConversionVerbs are the fmt verbs recognized as printf-style format specs,
+// e.g. the "f" in "{price:.2f}" or the "d" in "{count:05d}".
+const fmtConversionVerbs = "dfegsvxXboqt"
+
+// strftimeTokens maps the strftime directives supported in date/time format
+// specs (e.g. "{date:%Y-%m-%d}") to Go's reference-time layout.
+var strftimeTokens = []struct {
+	token  string
+	layout string
+}{
+	{"%Y", "2006"},
+	{"%m", "01"},
+	{"%d", "02"},
+	{"%H", "15"},
+	{"%M", "04"},
+	{"%S", "05"},
+}
+
+// applyFormatSpec renders value according to a placeholder's format spec
+// (the part after the colon in "{name:spec}"). An empty spec falls back to
+// formatValue's default rendering.
+func applyFormatSpec(value interface{}, spec string) (string, error) {
+	if spec == "" {
+		return formatValue(value), nil
+	}
+
+	switch {
+	case len(spec) > 0 && strings.ContainsRune("<>^", rune(spec[0])):
+		return applyAlignmentSpec(value, spec)
+	case strings.Contains(spec, "%"):
+		return applyStrftimeSpec(value, spec)
+	case strings.ContainsRune(fmtConversionVerbs, rune(spec[len(spec)-1])):
+		return applyPrintfSpec(value, spec)
+	default:
+		return "", fmt.Errorf("unrecognized format spec %q", spec)
+	}
+}
+
+// applyPrintfSpec feeds the value through fmt.Sprintf using the spec as a
+// verb, e.g. spec ".2f" becomes the format string "%.2f".
+func applyPrintfSpec(value interface{}, spec string) (string, error) {
+	result := fmt.Sprintf("%"+spec, value)
+	if strings.Contains(result, "%!") {
+		return "", fmt.Errorf("spec %q cannot be applied to type %T", spec, value)
+	}
+
+	return result, nil
+}
+
+// applyStrftimeSpec translates strftime-style tokens in spec to a Go layout
+// and formats a time.Time value with it.
+func applyStrftimeSpec(value interface{}, spec string) (string, error) {
+	t, ok := value.(time.Time)
+	if !ok {
+		return "", fmt.Errorf("spec %q requires a time.Time value, got %T", spec, value)
+	}
+
+	layout := spec
+	for _, tok := range strftimeTokens {
+		layout = strings.ReplaceAll(layout, tok.token, tok.layout)
+	}
+
+	return t.Format(layout), nil
+}
+
+// applyAlignmentSpec pads the value's default string rendering to the given
+// width, aligning left ("<"), right (">"), or center ("^").
+func applyAlignmentSpec(value interface{}, spec string) (string, error) {
+	align := spec[0]
+
+	width, err := strconv.Atoi(spec[1:])
+	if err != nil || width < 0 {
+		return "", fmt.Errorf("alignment spec %q has an invalid width", spec)
+	}
+
+	str := formatValue(value)
+	if len(str) >= width {
+		return str, nil
+	}
+
+	pad := width - len(str)
+	switch align {
+	case '<':
+		return str + strings.Repeat(" ", pad), nil
+	case '>':
+		return strings.Repeat(" ", pad) + str, nil
+	case '^':
+		left := pad / 2
+		right := pad - left
+
+		return strings.Repeat(" ", left) + str + strings.Repeat(" ", right), nil
+	default:
+		return "", fmt.Errorf("unknown alignment character %q", align)
+	}
+}
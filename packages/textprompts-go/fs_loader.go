@@ -0,0 +1,80 @@
+package textprompts
+
+import (
+	"io/fs"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// LoadPromptFS loads a single prompt from fsys at path. It is the io/fs.FS
+// counterpart to LoadPrompt, letting prompts be shipped inside a binary via
+// //go:embed, read from an in-memory filesystem in tests, or served from any
+// other virtual filesystem.
+func LoadPromptFS(fsys fs.FS, path string, opts ...LoadOption) (*Prompt, error) {
+	options := defaultLoadOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return parseFileFS(fsys, path, resolveMode(options), resolveWarnHandler(options))
+}
+
+// LoadPromptsFS loads every prompt matching glob under fsys. It is the
+// io/fs.FS counterpart to LoadPrompts.
+func LoadPromptsFS(fsys fs.FS, glob string, opts ...LoadOption) ([]*Prompt, error) {
+	options := defaultLoadOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	matches, err := doublestar.Glob(fsys, glob)
+	if err != nil {
+		return nil, &TextPromptsError{
+			Message: "invalid glob pattern",
+			Cause:   err,
+		}
+	}
+
+	mode := resolveMode(options)
+	warnHandler := resolveWarnHandler(options)
+
+	prompts := make([]*Prompt, 0, len(matches))
+	for _, match := range matches {
+		info, err := fs.Stat(fsys, match)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		prompt, err := parseFileFS(fsys, match, mode, warnHandler)
+		if err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, prompt)
+	}
+
+	if options.maxFiles > 0 && len(prompts) > options.maxFiles {
+		prompts = prompts[:options.maxFiles]
+	}
+
+	return prompts, nil
+}
+
+// resolveMode returns options.mode if it was overridden, or the global
+// default metadata mode otherwise.
+func resolveMode(options *loadOptions) MetadataMode {
+	if options.mode != nil {
+		return *options.mode
+	}
+
+	return GetMetadata()
+}
+
+// resolveWarnHandler returns options.warnHandler if it was overridden with
+// WithWarnHandler, or the global handler set by SetWarnHandler otherwise.
+func resolveWarnHandler(options *loadOptions) WarnHandler {
+	if options.warnHandler != nil {
+		return options.warnHandler
+	}
+
+	return GetWarnHandler()
+}
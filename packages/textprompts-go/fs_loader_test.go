@@ -0,0 +1,73 @@
+package textprompts
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadPromptFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"greeting.txt": &fstest.MapFile{Data: []byte("---\ntitle = \"Greeting\"\nversion = \"1.0.0\"\ndescription = \"d\"\n---\nHello, {name}!")},
+	}
+
+	prompt, err := LoadPromptFS(fsys, "greeting.txt")
+	if err != nil {
+		t.Fatalf("LoadPromptFS() error = %v", err)
+	}
+	if got := prompt.Meta.GetTitle(); got != "Greeting" {
+		t.Errorf("LoadPromptFS() title = %q, want %q", got, "Greeting")
+	}
+	if prompt.Path != "greeting.txt" {
+		t.Errorf("LoadPromptFS() path = %q, want %q", prompt.Path, "greeting.txt")
+	}
+
+	if _, err := LoadPromptFS(fsys, "does-not-exist.txt"); !IsFileMissing(err) {
+		t.Errorf("LoadPromptFS() error = %v, want FileMissingError", err)
+	}
+}
+
+func TestLoadPromptsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"prompts/greeting.txt": &fstest.MapFile{Data: []byte("Hello, {name}!")},
+		"prompts/farewell.txt": &fstest.MapFile{Data: []byte("Bye, {name}!")},
+		"prompts/readme.md":    &fstest.MapFile{Data: []byte("not a prompt")},
+	}
+
+	prompts, err := LoadPromptsFS(fsys, "prompts/*.txt")
+	if err != nil {
+		t.Fatalf("LoadPromptsFS() error = %v", err)
+	}
+	if len(prompts) != 2 {
+		t.Errorf("LoadPromptsFS() returned %d prompts, want 2", len(prompts))
+	}
+}
+
+func TestLoadPromptWithFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"greeting.txt": &fstest.MapFile{Data: []byte("---\ntitle = \"Greeting\"\nversion = \"1.0.0\"\ndescription = \"d\"\n---\nHello, {name}!")},
+	}
+
+	prompt, err := LoadPrompt("greeting.txt", WithFS(fsys))
+	if err != nil {
+		t.Fatalf("LoadPrompt() with WithFS error = %v", err)
+	}
+	if got := prompt.Meta.GetTitle(); got != "Greeting" {
+		t.Errorf("LoadPrompt() with WithFS title = %q, want %q", got, "Greeting")
+	}
+}
+
+func TestLoadPromptsWithFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"prompts/greeting.txt": &fstest.MapFile{Data: []byte("Hello, {name}!")},
+		"prompts/farewell.txt": &fstest.MapFile{Data: []byte("Bye, {name}!")},
+		"prompts/readme.md":    &fstest.MapFile{Data: []byte("not a prompt")},
+	}
+
+	prompts, err := LoadPrompts([]string{"prompts"}, WithFS(fsys))
+	if err != nil {
+		t.Fatalf("LoadPrompts() with WithFS error = %v", err)
+	}
+	if len(prompts) != 2 {
+		t.Errorf("LoadPrompts() with WithFS returned %d prompts, want 2", len(prompts))
+	}
+}
@@ -1,18 +1,39 @@
 package textprompts
 
 import (
+	"io/fs"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"golang.org/x/text/language"
 )
 
+// FS is the filesystem abstraction WithFS accepts. It is an alias for
+// io/fs.FS: any value with an Open method works here, while the loader uses
+// fs.Stat/fs.ReadFile/doublestar.Glob, which transparently take the faster
+// path when the concrete type also implements fs.StatFS/fs.ReadFileFS/
+// fs.GlobFS (as os.DirFS, embed.FS, and testing/fstest.MapFS all do).
+type FS = fs.FS
+
 // loadOptions holds configuration for loading prompts.
 type loadOptions struct {
-	mode      *MetadataMode
-	recursive bool
-	glob      string
-	maxFiles  int
+	mode             *MetadataMode
+	recursive        bool
+	glob             string
+	maxFiles         int
+	locale           *language.Tag
+	warnHandler      WarnHandler
+	fsys             FS
+	symlinkPolicy    SymlinkPolicy
+	allowDuplicates  bool
+	httpClient       *http.Client
+	cacheDir         string
+	cacheTTL         time.Duration
+	fetcherOverrides map[string]Fetcher
 }
 
 func defaultLoadOptions() *loadOptions {
@@ -55,20 +76,99 @@ func WithMaxFiles(n int) LoadOption {
 	}
 }
 
-// LoadPrompt loads a single prompt file.
+// WithLocale makes LoadPrompt resolve the locale variant of path closest to
+// tag (e.g. "greeting.txt" with WithLocale(language.French) resolves to
+// "greeting.fr.txt" if present), falling back to the base file when no
+// variant matches. See Catalog for the full fallback chain.
+func WithLocale(tag language.Tag) LoadOption {
+	return func(o *loadOptions) {
+		o.locale = &tag
+	}
+}
+
+// WithWarnHandler overrides the WarnHandler used for this load in place of
+// the global handler set by SetWarnHandler.
+func WithWarnHandler(h WarnHandler) LoadOption {
+	return func(o *loadOptions) {
+		o.warnHandler = h
+	}
+}
+
+// WithAllowDuplicates disables LoadPrompts' deduplication step, so a file
+// reachable under two different paths (e.g. passed directly and again via a
+// directory it's in, or through a symlink) is loaded and returned twice.
+func WithAllowDuplicates() LoadOption {
+	return func(o *loadOptions) {
+		o.allowDuplicates = true
+	}
+}
+
+// WithFS makes LoadPrompt/LoadPrompts read from fsys instead of the OS
+// filesystem, so prompts bundled with //go:embed, held in an in-memory
+// filesystem for tests, or served by any other io/fs.FS can be loaded
+// through the same API as disk-backed prompts:
+//
+//	textprompts.LoadPrompts([]string{"prompts"},
+//		textprompts.WithFS(myEmbedFS), textprompts.WithRecursive())
+//
+// LoadPromptFS/LoadPromptsFS remain available as direct entry points when
+// callers already have an fs.FS in hand and don't need WithFS's other
+// options (e.g. WithLocale).
+func WithFS(fsys FS) LoadOption {
+	return func(o *loadOptions) {
+		o.fsys = fsys
+	}
+}
+
+// LoadPrompt loads a single prompt file from the OS filesystem. It is a thin
+// wrapper around LoadPromptFS using os.DirFS, kept as its own entry point so
+// callers don't need to split paths into a directory and fs.FS-relative name
+// themselves.
 func LoadPrompt(path string, opts ...LoadOption) (*Prompt, error) {
 	options := defaultLoadOptions()
 	for _, opt := range opts {
 		opt(options)
 	}
 
-	mode := options.mode
-	if mode == nil {
-		m := GetMetadata()
-		mode = &m
+	if options.locale != nil {
+		if options.fsys == nil {
+			path = resolveLocalizedPath(path, *options.locale)
+		} else {
+			ext := filepath.Ext(path)
+			stem := path[:len(path)-len(ext)]
+			for _, candidate := range localeFallbackChain(*options.locale) {
+				variant := stem + "." + candidate + ext
+				if info, err := fs.Stat(options.fsys, variant); err == nil && !info.IsDir() {
+					path = variant
+					break
+				}
+			}
+		}
+	}
+
+	if isRemotePath(path) {
+		return loadRemotePrompt(path, resolveMode(options), resolveWarnHandler(options), options)
+	}
+
+	if options.fsys != nil {
+		return parseFileFS(options.fsys, path, resolveMode(options), resolveWarnHandler(options))
 	}
 
-	return parseFile(path, *mode)
+	dir := filepath.Dir(path)
+	name := filepath.Base(path)
+
+	prompt, err := parseFileFS(os.DirFS(dir), name, resolveMode(options), resolveWarnHandler(options))
+	if err != nil {
+		return nil, err
+	}
+
+	if absPath, err := filepath.Abs(path); err == nil {
+		prompt.Path = absPath
+	} else {
+		prompt.Path = path
+	}
+
+	return prompt, nil
 }
 
 // LoadPrompts loads multiple prompts from paths, directories, or glob patterns.
@@ -78,34 +178,35 @@ func LoadPrompts(paths []string, opts ...LoadOption) ([]*Prompt, error) {
 		opt(options)
 	}
 
-	mode := options.mode
-	if mode == nil {
-		m := GetMetadata()
-		mode = &m
-	}
+	mode := resolveMode(options)
+	warnHandler := resolveWarnHandler(options)
 
 	var allFiles []string
 
-	for _, path := range paths {
-		files, err := resolvePath(path, options)
+	for _, p := range paths {
+		var (
+			files []string
+			err   error
+		)
+		if options.fsys != nil {
+			files, err = resolvePathFS(options.fsys, p, options)
+		} else {
+			files, err = resolvePath(p, options)
+		}
 		if err != nil {
 			return nil, err
 		}
 		allFiles = append(allFiles, files...)
 	}
 
-	// Deduplicate files
-	seen := make(map[string]struct{})
+	// Deduplicate files, unless the caller opted out with WithAllowDuplicates.
+	dedup := newDedupSet(options.allowDuplicates)
 	var uniqueFiles []string
 	for _, f := range allFiles {
-		absPath, err := filepath.Abs(f)
-		if err != nil {
-			absPath = f
-		}
-		if _, exists := seen[absPath]; !exists {
-			seen[absPath] = struct{}{}
-			uniqueFiles = append(uniqueFiles, f)
+		if dedup.seen(f, options) {
+			continue
 		}
+		uniqueFiles = append(uniqueFiles, f)
 	}
 
 	// Apply max files limit
@@ -114,9 +215,37 @@ func LoadPrompts(paths []string, opts ...LoadOption) ([]*Prompt, error) {
 	}
 
 	// Load all prompts
+	archiveFS := make(map[string]FS)
 	prompts := make([]*Prompt, 0, len(uniqueFiles))
 	for _, file := range uniqueFiles {
-		prompt, err := parseFile(file, *mode)
+		var (
+			prompt *Prompt
+			err    error
+		)
+		switch {
+		case isRemotePath(file):
+			prompt, err = loadRemotePrompt(file, mode, warnHandler, options)
+		case options.fsys != nil:
+			prompt, err = parseFileFS(options.fsys, file, mode, warnHandler)
+		default:
+			if archivePath, entryPath, ok := splitArchiveEntryPath(file); ok {
+				fsys, cached := archiveFS[archivePath]
+				if !cached {
+					fsys, err = openArchive(archivePath)
+					if err != nil {
+						return nil, &TextPromptsError{Message: "failed to open archive", Cause: err}
+					}
+					archiveFS[archivePath] = fsys
+				}
+
+				prompt, err = parseFileFS(fsys, entryPath, mode, warnHandler)
+				if err == nil {
+					prompt.Path = file
+				}
+			} else {
+				prompt, err = parseFile(file, mode, warnHandler)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -126,9 +255,80 @@ func LoadPrompts(paths []string, opts ...LoadOption) ([]*Prompt, error) {
 	return prompts, nil
 }
 
+// dedupSet tracks files already seen by LoadPrompts so the same file isn't
+// loaded twice. Local OS paths are matched by identity (device and inode, or
+// their platform equivalent) via os.SameFile, so "foo.txt", "./foo.txt", a
+// symlink to foo.txt, and foo.txt's containing directory all collapse to one
+// entry; remote URLs, fsys-backed paths, and archive entries aren't real OS
+// files, so they dedupe by a cleaned string key instead.
+type dedupSet struct {
+	allowDuplicates bool
+	keys            map[string]struct{}
+	infos           []os.FileInfo
+}
+
+func newDedupSet(allowDuplicates bool) *dedupSet {
+	return &dedupSet{allowDuplicates: allowDuplicates, keys: make(map[string]struct{})}
+}
+
+// seen reports whether f was already recorded, recording it if not.
+func (d *dedupSet) seen(f string, options *loadOptions) bool {
+	if d.allowDuplicates {
+		return false
+	}
+
+	switch {
+	case isRemotePath(f):
+		return d.seenKey(f)
+	case options.fsys != nil:
+		return d.seenKey(path.Clean(f))
+	}
+
+	if _, _, ok := splitArchiveEntryPath(f); ok {
+		return d.seenKey(f)
+	}
+
+	if info, err := os.Stat(f); err == nil {
+		for _, s := range d.infos {
+			if os.SameFile(info, s) {
+				return true
+			}
+		}
+		d.infos = append(d.infos, info)
+		return false
+	}
+
+	// Stat failed (e.g. the path doesn't exist yet and will error later);
+	// fall back to the cleaned absolute path.
+	key := f
+	if absPath, err := filepath.Abs(f); err == nil {
+		key = absPath
+	}
+	return d.seenKey(key)
+}
+
+func (d *dedupSet) seenKey(key string) bool {
+	if _, exists := d.keys[key]; exists {
+		return true
+	}
+	d.keys[key] = struct{}{}
+	return false
+}
+
 // resolvePath resolves a path to a list of files.
-// Handles directories, glob patterns, and individual files.
+// Handles directories, glob patterns, individual files, archives
+// (.zip/.tar/.tar.gz/.tar.bz2), which are opened and walked as a virtual
+// directory of prompts, and remote http(s)/git+https URLs, which are
+// returned as-is for loadRemotePrompt to fetch.
 func resolvePath(path string, options *loadOptions) ([]string, error) {
+	if isRemotePath(path) {
+		return []string{path}, nil
+	}
+
+	if isArchivePath(path) {
+		return resolveArchivePath(path, options)
+	}
+
 	info, err := os.Stat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -150,17 +350,36 @@ func resolvePath(path string, options *loadOptions) ([]string, error) {
 	return findFilesInDir(path, options)
 }
 
-// findFilesInDir finds files matching the glob pattern in a directory.
-func findFilesInDir(dir string, options *loadOptions) ([]string, error) {
+// resolvePathFS is the fs.FS counterpart to resolvePath, used when WithFS
+// supplies a filesystem abstraction instead of the OS filesystem.
+func resolvePathFS(fsys FS, p string, options *loadOptions) ([]string, error) {
+	info, err := fs.Stat(fsys, p)
+	if err != nil {
+		// Try as glob pattern
+		matches, globErr := doublestar.Glob(fsys, p)
+		if globErr != nil {
+			return nil, &TextPromptsError{
+				Message: "failed to access path",
+				Cause:   err,
+			}
+		}
+		return filterFilesFS(fsys, matches), nil
+	}
+
+	if !info.IsDir() {
+		// Single file
+		return []string{p}, nil
+	}
+
+	// Directory - find matching files
 	var pattern string
 	if options.recursive {
-		pattern = filepath.Join(dir, "**", options.glob)
+		pattern = path.Join(p, "**", options.glob)
 	} else {
-		pattern = filepath.Join(dir, options.glob)
+		pattern = path.Join(p, options.glob)
 	}
 
-	// Use doublestar for glob matching
-	matches, err := doublestar.FilepathGlob(pattern)
+	matches, err := doublestar.Glob(fsys, pattern)
 	if err != nil {
 		return nil, &TextPromptsError{
 			Message: "invalid glob pattern",
@@ -168,21 +387,122 @@ func findFilesInDir(dir string, options *loadOptions) ([]string, error) {
 		}
 	}
 
-	// Filter out directories
+	return filterFilesFS(fsys, matches), nil
+}
+
+// filterFilesFS drops directories out of matches, keeping only regular files.
+func filterFilesFS(fsys FS, matches []string) []string {
 	var files []string
 	for _, match := range matches {
-		info, err := os.Stat(match)
-		if err != nil {
+		info, err := fs.Stat(fsys, match)
+		if err != nil || info.IsDir() {
 			continue
 		}
-		if !info.IsDir() {
-			files = append(files, match)
+		files = append(files, match)
+	}
+
+	return files
+}
+
+// findFilesInDir finds files matching the glob pattern in a directory,
+// walking it manually (rather than handing the whole pattern to
+// doublestar.FilepathGlob) so options.symlinkPolicy can be applied to each
+// entry and, when options.recursive is set, symlink cycles can be detected
+// by tracking directories already visited by their resolved path.
+func findFilesInDir(dir string, options *loadOptions) ([]string, error) {
+	var pattern string
+	if options.recursive {
+		pattern = filepath.Join(dir, "**", options.glob)
+	} else {
+		pattern = filepath.Join(dir, options.glob)
+	}
+	pattern = filepath.ToSlash(pattern)
+
+	visited := make(map[string]struct{})
+	var files []string
+
+	var walk func(current string) error
+	walk = func(current string) error {
+		if real, err := filepath.EvalSymlinks(current); err == nil {
+			if _, seen := visited[real]; seen {
+				return nil
+			}
+			visited[real] = struct{}{}
+		}
+
+		entries, err := os.ReadDir(current)
+		if err != nil {
+			return &TextPromptsError{
+				Message: "failed to read directory",
+				Cause:   err,
+			}
 		}
+
+		for _, entry := range entries {
+			entryPath := filepath.Join(current, entry.Name())
+
+			lst, err := os.Lstat(entryPath)
+			if err != nil {
+				continue
+			}
+
+			isSymlink := lst.Mode()&os.ModeSymlink != 0
+			if isSymlink {
+				switch options.symlinkPolicy {
+				case SymlinkSkip:
+					continue
+				case SymlinkErrorPolicy:
+					return NewSymlinkError(entryPath)
+				}
+			}
+
+			info := lst
+			if isSymlink {
+				// SymlinkFollow: resolve what the link points to.
+				resolved, err := os.Stat(entryPath)
+				if err != nil {
+					return NewDanglingSymlinkError(entryPath)
+				}
+				info = resolved
+			}
+
+			if info.IsDir() {
+				if options.recursive {
+					if err := walk(entryPath); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			matched, err := doublestar.Match(pattern, filepath.ToSlash(entryPath))
+			if err != nil {
+				return &TextPromptsError{
+					Message: "invalid glob pattern",
+					Cause:   err,
+				}
+			}
+			if matched {
+				files = append(files, entryPath)
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(dir); err != nil {
+		return nil, err
 	}
 
 	return files, nil
 }
 
+// fileExists reports whether path exists and is a regular file.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
 // resolveGlob resolves a glob pattern to matching files.
 func resolveGlob(pattern string) ([]string, error) {
 	matches, err := doublestar.FilepathGlob(pattern)
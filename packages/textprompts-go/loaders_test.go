@@ -208,6 +208,38 @@ func TestLoadPrompts(t *testing.T) {
 			t.Errorf("LoadPrompts() returned %d prompts, want 1 (deduplicated)", len(prompts))
 		}
 	})
+
+	t.Run("deduplicate by identity not just string path", func(t *testing.T) {
+		prompts, err := LoadPrompts(
+			[]string{"testdata/valid/simple.txt", "./testdata/valid/simple.txt", "testdata/valid"},
+			WithMetadataMode(ModeAllow),
+		)
+		if err != nil {
+			t.Fatalf("LoadPrompts() error = %v", err)
+		}
+		found := 0
+		for _, p := range prompts {
+			if filepath.Base(p.Path) == "simple.txt" {
+				found++
+			}
+		}
+		if found != 1 {
+			t.Errorf("LoadPrompts() returned simple.txt %d times, want 1 (same file via direct path, \"./\" path, and containing directory)", found)
+		}
+	})
+
+	t.Run("WithAllowDuplicates disables deduplication", func(t *testing.T) {
+		prompts, err := LoadPrompts(
+			[]string{"testdata/valid/simple.txt", "testdata/valid/simple.txt"},
+			WithMetadataMode(ModeAllow), WithAllowDuplicates(),
+		)
+		if err != nil {
+			t.Fatalf("LoadPrompts() error = %v", err)
+		}
+		if len(prompts) != 2 {
+			t.Errorf("LoadPrompts() returned %d prompts, want 2 (WithAllowDuplicates keeps duplicates)", len(prompts))
+		}
+	})
 }
 
 func TestLoadPromptEdgeCases(t *testing.T) {
@@ -1,6 +1,7 @@
 package textprompts
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
@@ -45,6 +46,9 @@ type PromptMeta struct {
 	Author      *string   `toml:"author"`
 	Created     *TomlDate `toml:"created"`
 	Description *string   `toml:"description"`
+	// Locale is a BCP-47 tag (e.g. "fr-FR") that overrides the locale
+	// otherwise derived from the filename. See Catalog.
+	Locale *string `toml:"locale"`
 }
 
 // IsEmpty returns true if all fields are nil or empty.
@@ -53,6 +57,7 @@ func (m PromptMeta) IsEmpty() bool {
 		(m.Version == nil || *m.Version == "") &&
 		(m.Author == nil || *m.Author == "") &&
 		(m.Description == nil || *m.Description == "") &&
+		(m.Locale == nil || *m.Locale == "") &&
 		m.Created == nil
 }
 
@@ -114,6 +119,15 @@ func (m PromptMeta) GetDescription() string {
 	return *m.Description
 }
 
+// GetLocale returns the locale override or empty string if nil.
+func (m PromptMeta) GetLocale() string {
+	if m.Locale == nil {
+		return ""
+	}
+
+	return *m.Locale
+}
+
 // GetCreated returns the created time or zero time if nil.
 func (m PromptMeta) GetCreated() time.Time {
 	if m.Created == nil {
@@ -130,9 +144,19 @@ type Prompt struct {
 	Prompt PromptString // The prompt content with formatting support
 }
 
-// Format is a convenience method that delegates to Prompt.Prompt.Format.
+// Format is a convenience method that delegates to Prompt.Prompt.Format,
+// attaching the prompt's source path to any FormatError so log lines can
+// point at the offending file.
 func (p *Prompt) Format(values map[string]interface{}, opts ...FormatOption) (string, error) {
-	return p.Prompt.Format(values, opts...)
+	result, err := p.Prompt.Format(values, opts...)
+	if err != nil {
+		var fe *FormatError
+		if errors.As(err, &fe) {
+			fe.Path = p.Path
+		}
+	}
+
+	return result, err
 }
 
 // MustFormat is a convenience method that delegates to Prompt.Prompt.MustFormat.
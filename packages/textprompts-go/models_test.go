@@ -131,6 +131,7 @@ func TestPromptMetaGetters(t *testing.T) {
 		Author:      StringPtr("Author"),
 		Description: StringPtr("Description"),
 		Created:     TimePtr(now),
+		Locale:      StringPtr("fr-FR"),
 	}
 
 	if got := meta.GetTitle(); got != "Test" {
@@ -148,6 +149,9 @@ func TestPromptMetaGetters(t *testing.T) {
 	if got := meta.GetCreated(); !got.Equal(now) {
 		t.Errorf("GetCreated() = %v, want %v", got, now)
 	}
+	if got := meta.GetLocale(); got != "fr-FR" {
+		t.Errorf("GetLocale() = %q, want %q", got, "fr-FR")
+	}
 }
 
 func TestPromptMetaGettersNil(t *testing.T) {
@@ -168,6 +172,9 @@ func TestPromptMetaGettersNil(t *testing.T) {
 	if got := meta.GetCreated(); !got.IsZero() {
 		t.Errorf("GetCreated() = %v, want zero time", got)
 	}
+	if got := meta.GetLocale(); got != "" {
+		t.Errorf("GetLocale() = %q, want empty", got)
+	}
 }
 
 func TestPromptFormat(t *testing.T) {
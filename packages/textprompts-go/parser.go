@@ -1,6 +1,8 @@
 package textprompts
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -52,8 +54,34 @@ func splitFrontMatter(content string) (tomlContent, body string, hasFrontmatter
 	return tomlContent, body, true
 }
 
+// tomlErrorLocation translates a BurntSushi/toml decode error's position,
+// which is relative to the frontmatter block alone, back into a
+// SourceLocation within the original file content, including a short
+// excerpt of the offending line. It returns a location with just Path set
+// if err isn't a *toml.ParseError or carries no position.
+func tomlErrorLocation(sourcePath, content string, err error) SourceLocation {
+	loc := SourceLocation{Path: sourcePath}
+
+	var perr toml.ParseError
+	if !errors.As(err, &perr) {
+		return loc
+	}
+
+	// Frontmatter content starts at line 2 of the source file (line 1 is
+	// the opening "---" delimiter), so the decoder's line number, which is
+	// 1-based and relative to tomlContent, needs a +1 offset.
+	loc.Line = perr.Position.Line + 1
+	loc.Col = perr.Position.Col
+
+	if lines := strings.Split(content, "\n"); loc.Line >= 1 && loc.Line <= len(lines) {
+		loc.Excerpt = strings.TrimRight(lines[loc.Line-1], "\r")
+	}
+
+	return loc
+}
+
 // parseFile reads and parses a prompt file.
-func parseFile(path string, mode MetadataMode) (*Prompt, error) {
+func parseFile(path string, mode MetadataMode, warnHandler WarnHandler) (*Prompt, error) {
 	// Read file contents
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -72,17 +100,44 @@ func parseFile(path string, mode MetadataMode) (*Prompt, error) {
 		absPath = path
 	}
 
-	return parseString(string(data), mode, absPath)
+	return parseString(string(data), mode, absPath, warnHandler)
+}
+
+// parseFileFS reads and parses a prompt file from an io/fs.FS, the virtual-
+// filesystem counterpart to parseFile.
+func parseFileFS(fsys fs.FS, path string, mode MetadataMode, warnHandler WarnHandler) (*Prompt, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, NewFileMissingError(path, err)
+		}
+
+		return nil, &TextPromptsError{
+			Message: "failed to read file",
+			Cause:   err,
+		}
+	}
+
+	return parseString(string(data), mode, path, warnHandler)
 }
 
 // parseString parses prompt content from a string.
-func parseString(content string, mode MetadataMode, sourcePath string) (*Prompt, error) {
+func parseString(content string, mode MetadataMode, sourcePath string, warnHandler WarnHandler) (*Prompt, error) {
 	prompt := &Prompt{
 		Path: sourcePath,
 	}
 
 	// Handle IGNORE mode - treat entire content as body
 	if mode == ModeIgnore {
+		if _, _, hadFrontmatter := splitFrontMatter(content); hadFrontmatter {
+			emitWarn(warnHandler, WarnEvent{
+				Path:     sourcePath,
+				Mode:     mode,
+				Fields:   []string{"frontmatter"},
+				Severity: WarnInfo,
+				Message:  "metadata frontmatter present but ignored due to ModeIgnore",
+			})
+		}
 		prompt.Prompt = NewPromptString(content)
 		// Set title from filename if available
 		if sourcePath != "" {
@@ -116,7 +171,7 @@ func parseString(content string, mode MetadataMode, sourcePath string) (*Prompt,
 	// Parse TOML metadata
 	var meta PromptMeta
 	if _, err := toml.Decode(tomlContent, &meta); err != nil {
-		return nil, NewInvalidMetadataError(sourcePath, err.Error(), err)
+		return nil, NewInvalidMetadataErrorAt(sourcePath, err.Error(), err, tomlErrorLocation(sourcePath, content, err))
 	}
 
 	// Validate in strict mode
@@ -151,5 +206,5 @@ func FromString(content string, opts ...LoadOption) (*Prompt, error) {
 		mode = &m
 	}
 
-	return parseString(content, *mode, "")
+	return parseString(content, *mode, "", resolveWarnHandler(options))
 }
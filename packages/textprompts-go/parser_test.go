@@ -1,6 +1,8 @@
 package textprompts
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -187,3 +189,27 @@ Body`,
 		})
 	}
 }
+
+func TestInvalidTOMLErrorLocation(t *testing.T) {
+	content := "---\ntitle = \"Missing quote\n---\nBody"
+
+	_, err := parseString(content, ModeAllow, "/prompts/bad.txt", nil)
+
+	var invalidErr *InvalidMetadataError
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("parseString() error = %v, want *InvalidMetadataError", err)
+	}
+
+	path, line, _ := invalidErr.Location()
+	if path != "/prompts/bad.txt" {
+		t.Errorf("Location() path = %q, want %q", path, "/prompts/bad.txt")
+	}
+	// The unterminated string is on line 2 of content (line 1 is the "---").
+	if line != 2 {
+		t.Errorf("Location() line = %d, want 2", line)
+	}
+
+	if got := invalidErr.Error(); !strings.Contains(got, "/prompts/bad.txt:2") {
+		t.Errorf("Error() = %q, want it to contain %q", got, "/prompts/bad.txt:2")
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Package pipeline extracts references to textprompts prompt files and inline
+templates from Go source code and records them in a manifest, so CI can
+catch dangling prompt references and placeholder drift before runtime.
+
+# Extraction
+
+Extract walks the packages matched by a go/packages pattern and records
+every call to textprompts.LoadPrompt, textprompts.LoadPrompts, and
+textprompts.NewPromptString whose path/template argument is a string
+literal:
+
+	manifest, err := pipeline.Extract(".", "./...")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := pipeline.WriteManifest(pipeline.DefaultManifestName, manifest); err != nil {
+		log.Fatal(err)
+	}
+
+# Validation
+
+Validate cross-checks every referenced file: it must exist, parse under the
+given MetadataMode, and its placeholders must match what was recorded when
+the manifest was generated.
+
+	manifest, err := pipeline.ReadManifest(pipeline.DefaultManifestName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := manifest.Validate(textprompts.ModeAllow); err != nil {
+		log.Fatal(err) // dangling reference or drifted placeholders
+	}
+*/
+package pipeline
@@ -0,0 +1,125 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+// DefaultManifestName is the conventional filename for a written manifest.
+const DefaultManifestName = "textprompts.manifest.json"
+
+// WriteManifest writes m to path as indented JSON.
+func WriteManifest(path string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("pipeline: encoding manifest: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("pipeline: writing manifest %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ReadManifest reads and decodes a manifest previously written by
+// WriteManifest.
+func ReadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("pipeline: reading manifest %s: %w", path, err)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("pipeline: decoding manifest %s: %w", path, err)
+	}
+
+	return &m, nil
+}
+
+// Validate cross-checks every referenced prompt file: it must exist, parse
+// under mode, and the placeholders recorded in the manifest must match what
+// the file actually contains. It returns a single error joining every
+// problem found, or nil if the manifest is consistent with the prompt files
+// on disk.
+func (m *Manifest) Validate(mode textprompts.MetadataMode) error {
+	var problems []string
+
+	for _, ref := range m.Prompts {
+		if ref.Path == "" {
+			continue // inline template, nothing on disk to check
+		}
+
+		prompt, err := textprompts.LoadPrompt(ref.Path, textprompts.WithMetadataMode(mode))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", ref.Path, err))
+			continue
+		}
+
+		if diff := placeholderDiff(ref.Placeholders, prompt.Prompt.Placeholders()); diff != "" {
+			problems = append(problems, fmt.Sprintf("%s: %s", ref.Path, diff))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+
+	return fmt.Errorf("pipeline: manifest validation failed:\n%s", joinLines(problems))
+}
+
+// placeholderDiff reports how recorded and actual placeholder sets disagree,
+// or "" if they match.
+func placeholderDiff(recorded, actual []string) string {
+	recordedSet := toSet(recorded)
+	actualSet := toSet(actual)
+
+	var missing, extra []string
+	for name := range recordedSet {
+		if _, ok := actualSet[name]; !ok {
+			extra = append(extra, name) // recorded but no longer in the file
+		}
+	}
+	for name := range actualSet {
+		if _, ok := recordedSet[name]; !ok {
+			missing = append(missing, name) // in the file but not recorded
+		}
+	}
+
+	if len(missing) == 0 && len(extra) == 0 {
+		return ""
+	}
+
+	sort.Strings(missing)
+	sort.Strings(extra)
+
+	return fmt.Sprintf("placeholder drift (missing from manifest: %v, no longer present: %v)", missing, extra)
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+
+	return set
+}
+
+func joinLines(lines []string) string {
+	out := ""
+	for i, line := range lines {
+		if i > 0 {
+			out += "\n"
+		}
+		out += "  - " + line
+	}
+
+	return out
+}
@@ -0,0 +1,64 @@
+package pipeline
+
+import (
+	"path/filepath"
+	"testing"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+func TestWriteReadManifestRoundTrip(t *testing.T) {
+	m := &Manifest{
+		Prompts: []PromptRef{
+			{
+				Path:         "testdata/prompts/greeting.txt",
+				Placeholders: []string{"name", "place"},
+				CallSites: []CallSite{
+					{Function: "LoadPrompt", File: "main.go", Line: 10, Column: 2},
+				},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), DefaultManifestName)
+	if err := WriteManifest(path, m); err != nil {
+		t.Fatalf("WriteManifest() error = %v", err)
+	}
+
+	got, err := ReadManifest(path)
+	if err != nil {
+		t.Fatalf("ReadManifest() error = %v", err)
+	}
+	if len(got.Prompts) != 1 || got.Prompts[0].Path != "testdata/prompts/greeting.txt" {
+		t.Errorf("ReadManifest() = %+v, want round-tripped manifest", got)
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	t.Run("matching placeholders", func(t *testing.T) {
+		m := &Manifest{Prompts: []PromptRef{
+			{Path: "testdata/prompts/greeting.txt", Placeholders: []string{"name", "place"}},
+		}}
+		if err := m.Validate(textprompts.ModeAllow); err != nil {
+			t.Errorf("Validate() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		m := &Manifest{Prompts: []PromptRef{
+			{Path: "testdata/prompts/does-not-exist.txt"},
+		}}
+		if err := m.Validate(textprompts.ModeAllow); err == nil {
+			t.Error("Validate() error = nil, want error for missing file")
+		}
+	})
+
+	t.Run("drifted placeholders", func(t *testing.T) {
+		m := &Manifest{Prompts: []PromptRef{
+			{Path: "testdata/prompts/greeting.txt", Placeholders: []string{"name"}},
+		}}
+		if err := m.Validate(textprompts.ModeAllow); err == nil {
+			t.Error("Validate() error = nil, want error for placeholder drift")
+		}
+	})
+}
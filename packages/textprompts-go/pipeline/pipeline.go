@@ -0,0 +1,186 @@
+// Package pipeline extracts textprompts call sites from Go source and
+// produces a manifest that can be validated in CI, analogous to what
+// golang.org/x/text/message/pipeline does for i18n messages.
+package pipeline
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/tools/go/packages"
+
+	textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+)
+
+// CallSite records where a prompt reference was found in source.
+type CallSite struct {
+	Function string `json:"function"` // "LoadPrompt", "LoadPrompts", or "NewPromptString"
+	File     string `json:"file"`     // source file containing the call
+	Line     int    `json:"line"`     // 1-based line of the call
+	Column   int    `json:"column"`   // 1-based column of the call
+}
+
+// PromptRef describes a single prompt referenced from Go source.
+type PromptRef struct {
+	// Path is the file argument passed to LoadPrompt/LoadPrompts (empty for
+	// inline templates).
+	Path string `json:"path,omitempty"`
+	// Inline is the literal template passed to NewPromptString (empty when
+	// Path is set).
+	Inline string `json:"inline,omitempty"`
+	// Placeholders is the set of placeholder names detected in the template,
+	// when it could be determined statically.
+	Placeholders []string `json:"placeholders"`
+	// CallSites lists every call that referenced this prompt.
+	CallSites []CallSite `json:"callSites"`
+}
+
+// Manifest is the extracted set of prompt references for a module or package
+// pattern.
+type Manifest struct {
+	Prompts []PromptRef `json:"prompts"`
+}
+
+// Extract walks the packages matching pattern (a go/packages load pattern,
+// e.g. "./..." or a directory) and collects every call to
+// textprompts.LoadPrompt, textprompts.LoadPrompts, and
+// textprompts.NewPromptString whose path/template argument is a string
+// literal.
+func Extract(dir, pattern string) (*Manifest, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:  dir,
+	}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("pipeline: loading packages: %w", err)
+	}
+
+	byKey := make(map[string]*PromptRef)
+	var order []string
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			fset := pkg.Fset
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				fn, arg, ok := matchPromptCall(call)
+				if !ok {
+					return true
+				}
+
+				pos := fset.Position(call.Pos())
+				site := CallSite{
+					Function: fn,
+					File:     pos.Filename,
+					Line:     pos.Line,
+					Column:   pos.Column,
+				}
+
+				for _, value := range stringLiteralValues(arg) {
+					key := fn + ":" + value
+
+					ref, exists := byKey[key]
+					if !exists {
+						ref = &PromptRef{}
+						if fn == "NewPromptString" {
+							ref.Inline = value
+							ref.Placeholders = textprompts.ExtractPlaceholders(value)
+						} else {
+							ref.Path = value
+							ref.Placeholders = filePlaceholders(dir, value)
+						}
+						byKey[key] = ref
+						order = append(order, key)
+					}
+					ref.CallSites = append(ref.CallSites, site)
+				}
+
+				return true
+			})
+		}
+	}
+
+	manifest := &Manifest{Prompts: make([]PromptRef, 0, len(order))}
+	for _, key := range order {
+		manifest.Prompts = append(manifest.Prompts, *byKey[key])
+	}
+
+	return manifest, nil
+}
+
+// matchPromptCall reports whether call is a call to one of the tracked
+// textprompts functions and, if so, returns the function name and its
+// path/template argument expression.
+func matchPromptCall(call *ast.CallExpr) (fn string, arg ast.Expr, ok bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", nil, false
+	}
+
+	pkgIdent, ok := sel.X.(*ast.Ident)
+	if !ok || pkgIdent.Name != "textprompts" {
+		return "", nil, false
+	}
+
+	switch sel.Sel.Name {
+	case "LoadPrompt", "LoadPrompts", "NewPromptString":
+	default:
+		return "", nil, false
+	}
+
+	if len(call.Args) == 0 {
+		return "", nil, false
+	}
+
+	return sel.Sel.Name, call.Args[0], true
+}
+
+// filePlaceholders loads the prompt file at path, resolved relative to dir,
+// and returns the placeholders found in its content. Validate, which has no
+// dir, resolves the same literal relative to the working directory, so the
+// two agree when dir is the working directory (e.g. Extract(".", ...)). It returns nil if the file can't be
+// loaded yet (e.g. it doesn't exist at extraction time); Validate surfaces
+// that as a missing-file problem on its own pass over the manifest.
+func filePlaceholders(dir, path string) []string {
+	prompt, err := textprompts.LoadPrompt(filepath.Join(dir, path), textprompts.WithMetadataMode(textprompts.ModeAllow))
+	if err != nil {
+		return nil
+	}
+
+	return prompt.Prompt.Placeholders()
+}
+
+// stringLiteralValues extracts every string-literal value reachable from
+// arg: a single "path/to/file.txt" literal, or a []string{"a", "b"}
+// composite literal as used in LoadPrompts calls.
+func stringLiteralValues(arg ast.Expr) []string {
+	switch e := arg.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return nil
+		}
+		if v, err := strconv.Unquote(e.Value); err == nil {
+			return []string{v}
+		}
+
+		return nil
+	case *ast.CompositeLit:
+		var values []string
+		for _, elt := range e.Elts {
+			values = append(values, stringLiteralValues(elt)...)
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package pipeline
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+func TestExtractEndToEnd(t *testing.T) {
+	manifest, err := Extract(".", "./testdata/fixture")
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	var ref *PromptRef
+	for i := range manifest.Prompts {
+		if manifest.Prompts[i].Path == "testdata/prompts/greeting.txt" {
+			ref = &manifest.Prompts[i]
+		}
+	}
+	if ref == nil {
+		t.Fatalf("Extract() manifest = %+v, want a ref for testdata/prompts/greeting.txt", manifest.Prompts)
+	}
+
+	want := []string{"name", "place"}
+	if !reflect.DeepEqual(ref.Placeholders, want) {
+		t.Errorf("Placeholders = %v, want %v (read from the file, not guessed from the path)", ref.Placeholders, want)
+	}
+}
+
+func parseCall(t *testing.T, src string) *ast.CallExpr {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", "package p\nvar _ = "+src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	var call *ast.CallExpr
+	ast.Inspect(file, func(n ast.Node) bool {
+		if c, ok := n.(*ast.CallExpr); ok && call == nil {
+			call = c
+		}
+		return true
+	})
+	if call == nil {
+		t.Fatalf("no call expression found in %q", src)
+	}
+
+	return call
+}
+
+func TestMatchPromptCall(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		wantFn string
+		wantOk bool
+	}{
+		{"LoadPrompt", `textprompts.LoadPrompt("greeting.txt")`, "LoadPrompt", true},
+		{"LoadPrompts", `textprompts.LoadPrompts([]string{"a.txt"})`, "LoadPrompts", true},
+		{"NewPromptString", `textprompts.NewPromptString("Hello {name}")`, "NewPromptString", true},
+		{"unrelated package", `other.LoadPrompt("greeting.txt")`, "", false},
+		{"unrelated function", `textprompts.SetMetadata(ModeStrict)`, "", false},
+		{"no args", `textprompts.LoadPrompt()`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := parseCall(t, tt.src)
+			fn, _, ok := matchPromptCall(call)
+			if ok != tt.wantOk || fn != tt.wantFn {
+				t.Errorf("matchPromptCall() = (%q, %v), want (%q, %v)", fn, ok, tt.wantFn, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestStringLiteralValues(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "single literal",
+			src:  `textprompts.LoadPrompt("greeting.txt")`,
+			want: []string{"greeting.txt"},
+		},
+		{
+			name: "slice literal",
+			src:  `textprompts.LoadPrompts([]string{"a.txt", "b.txt"})`,
+			want: []string{"a.txt", "b.txt"},
+		},
+		{
+			name: "non-literal argument",
+			src:  `textprompts.LoadPrompt(path)`,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := parseCall(t, tt.src)
+			_, arg, ok := matchPromptCall(call)
+			if !ok {
+				t.Fatalf("matchPromptCall() ok = false")
+			}
+			got := stringLiteralValues(arg)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("stringLiteralValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
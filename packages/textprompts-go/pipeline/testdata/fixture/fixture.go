@@ -0,0 +1,12 @@
+// Package fixture is a fixture consumed by TestExtractEndToEnd: a
+// standalone package with a real LoadPrompt call site for Extract to walk.
+package fixture
+
+import textprompts "github.com/svilupp/textprompts/packages/textprompts-go"
+
+// Greeting loads the greeting prompt Extract is expected to record, with its
+// placeholders read from testdata/prompts/greeting.txt rather than guessed
+// from the path string.
+func Greeting() (*textprompts.Prompt, error) {
+	return textprompts.LoadPrompt("testdata/prompts/greeting.txt")
+}
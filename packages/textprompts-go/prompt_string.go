@@ -85,12 +85,33 @@ func (ps PromptString) Format(values map[string]interface{}, opts ...FormatOptio
 	result = strings.ReplaceAll(result, "{{", escapedOpenMarker)
 	result = strings.ReplaceAll(result, "}}", escapedCloseMarker)
 
-	// Replace each placeholder with its value
+	// Replace each placeholder with its value, applying the format spec
+	// captured after the colon (e.g. "{price:.2f}") if present.
 	for name, value := range values {
-		// Handle {name} and {name:format} patterns
-		pattern := regexp.MustCompile(`\{` + regexp.QuoteMeta(name) + `(?::[^{}]*)?\}`)
-		strValue := formatValue(value)
-		result = pattern.ReplaceAllString(result, strValue)
+		pattern := regexp.MustCompile(`\{` + regexp.QuoteMeta(name) + `(?::([^{}]*))?\}`)
+
+		var specErr error
+		result = pattern.ReplaceAllStringFunc(result, func(match string) string {
+			if specErr != nil {
+				return match
+			}
+
+			spec := ""
+			if sub := pattern.FindStringSubmatch(match); len(sub) > 1 {
+				spec = sub[1]
+			}
+
+			formatted, err := applyFormatSpec(value, spec)
+			if err != nil {
+				specErr = NewFormatSpecError(name, spec, err)
+				return match
+			}
+
+			return formatted
+		})
+		if specErr != nil {
+			return "", specErr
+		}
 	}
 
 	// Restore escaped braces
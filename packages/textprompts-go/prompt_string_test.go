@@ -3,6 +3,7 @@ package textprompts
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewPromptString(t *testing.T) {
@@ -116,11 +117,47 @@ func TestPromptStringFormat(t *testing.T) {
 			expected: "Hello world!",
 		},
 		{
-			name:     "placeholder with format spec",
+			name:     "placeholder with printf format spec",
 			content:  "Price: {price:.2f}",
-			values:   map[string]interface{}{"price": "19.99"},
+			values:   map[string]interface{}{"price": 19.994},
 			expected: "Price: 19.99",
 		},
+		{
+			name:     "placeholder with zero-padded int spec",
+			content:  "Count: {count:05d}",
+			values:   map[string]interface{}{"count": 42},
+			expected: "Count: 00042",
+		},
+		{
+			name:     "placeholder with right alignment spec",
+			content:  "[{name:>10}]",
+			values:   map[string]interface{}{"name": "Alice"},
+			expected: "[     Alice]",
+		},
+		{
+			name:     "placeholder with left alignment spec",
+			content:  "[{name:<10}]",
+			values:   map[string]interface{}{"name": "Alice"},
+			expected: "[Alice     ]",
+		},
+		{
+			name:     "placeholder with strftime format spec",
+			content:  "Date: {date:%Y-%m-%d}",
+			values:   map[string]interface{}{"date": time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+			expected: "Date: 2024-01-15",
+		},
+		{
+			name:     "placeholder with bool format spec",
+			content:  "Active: {active:t}",
+			values:   map[string]interface{}{"active": true},
+			expected: "Active: true",
+		},
+		{
+			name:    "placeholder with unapplicable format spec",
+			content: "Price: {price:.2f}",
+			values:  map[string]interface{}{"price": "not-a-number"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
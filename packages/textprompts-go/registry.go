@@ -0,0 +1,374 @@
+package textprompts
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// RegistryEvent is sent on a Registry's subscription channel whenever a
+// reload is attempted. Err is nil on success; on failure, Key/Path identify
+// the file that failed to parse (if known) and the Registry keeps serving
+// the last-known-good *Prompt for that key, if any.
+type RegistryEvent struct {
+	Key  string
+	Path string
+	Err  error
+}
+
+// RegistryOption configures a Registry.
+type RegistryOption func(*registryOptions)
+
+type registryOptions struct {
+	loadOpts    []LoadOption
+	debounce    time.Duration
+	keyFunc     func(*Prompt) string
+	fileLocking bool
+}
+
+func defaultRegistryOptions() *registryOptions {
+	return &registryOptions{
+		debounce: 200 * time.Millisecond,
+		keyFunc:  func(p *Prompt) string { return p.Meta.GetTitle() },
+	}
+}
+
+// WithRegistryLoadOptions sets the LoadOptions applied on every (re)load,
+// e.g. WithRegistryLoadOptions(textprompts.WithRecursive(), textprompts.WithGlob("*.txt")).
+func WithRegistryLoadOptions(opts ...LoadOption) RegistryOption {
+	return func(o *registryOptions) {
+		o.loadOpts = opts
+	}
+}
+
+// WithDebounce sets how long the Registry waits after the last filesystem
+// event in a burst before reloading, absorbing the multiple events editors
+// typically generate for a single save (write + rename, etc). Default 200ms.
+func WithDebounce(d time.Duration) RegistryOption {
+	return func(o *registryOptions) {
+		o.debounce = d
+	}
+}
+
+// WithKeyFunc overrides how the Registry derives each prompt's lookup key.
+// The default is Meta.Title.
+func WithKeyFunc(f func(*Prompt) string) RegistryOption {
+	return func(o *registryOptions) {
+		o.keyFunc = f
+	}
+}
+
+// WithFileLocking makes every reload acquire the same "<path>.lock"
+// advisory lock SavePrompt's atomic Writer takes while replacing a file, so
+// a reload started mid-write blocks until the write finishes instead of
+// parsing a half-written file and surfacing a spurious InvalidMetadataError.
+// Off by default, since it only matters when something else writes the
+// watched files through SavePrompt/SavePromptContent while the Registry is
+// running.
+func WithFileLocking() RegistryOption {
+	return func(o *registryOptions) {
+		o.fileLocking = true
+	}
+}
+
+// Registry holds a set of prompts loaded from paths and, once Start is
+// called, watches them with fsnotify and reloads changed files in place.
+// If a reload fails, the Registry keeps serving the last-known-good
+// *Prompt for that key and surfaces the error on the subscription channel
+// instead of evicting it. A Registry operates on real OS paths/directories,
+// since fsnotify has nothing to watch for a WithFS- or archive-backed load.
+// The zero Registry is not usable; use NewRegistry.
+type Registry struct {
+	paths   []string
+	options *registryOptions
+
+	mu      sync.RWMutex
+	prompts map[string]*Prompt
+
+	subsMu sync.Mutex
+	subs   []chan RegistryEvent
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRegistry loads paths and returns a Registry serving them. Call Start
+// to begin watching the underlying files for changes.
+func NewRegistry(paths []string, opts ...RegistryOption) (*Registry, error) {
+	options := defaultRegistryOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	reg := &Registry{
+		paths:   paths,
+		options: options,
+		prompts: make(map[string]*Prompt),
+	}
+
+	if err := reg.reload(); err != nil {
+		return nil, err
+	}
+
+	return reg, nil
+}
+
+// Get returns the prompt registered under key, and whether it was found.
+func (r *Registry) Get(key string) (*Prompt, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.prompts[key]
+
+	return p, ok
+}
+
+// Keys returns the keys currently registered.
+func (r *Registry) Keys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]string, 0, len(r.prompts))
+	for k := range r.prompts {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Subscribe returns a channel that receives a RegistryEvent each time a
+// reload is attempted, on success or failure. The channel is buffered, but
+// a slow subscriber can still miss events under heavy reload churn. The
+// channel is closed when Close is called.
+func (r *Registry) Subscribe() <-chan RegistryEvent {
+	ch := make(chan RegistryEvent, 16)
+
+	r.subsMu.Lock()
+	r.subs = append(r.subs, ch)
+	r.subsMu.Unlock()
+
+	return ch
+}
+
+func (r *Registry) publish(event RegistryEvent) {
+	r.subsMu.Lock()
+	defer r.subsMu.Unlock()
+
+	for _, ch := range r.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Start begins watching the Registry's paths for changes in a background
+// goroutine, reloading affected prompts as they're edited. Watching stops
+// when ctx is cancelled or Close is called.
+func (r *Registry) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return &TextPromptsError{Message: "failed to create filesystem watcher", Cause: err}
+	}
+
+	dirs := r.watchDirs()
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return &TextPromptsError{Message: "failed to watch path", Cause: err}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go r.watchLoop(ctx, watcher)
+
+	return nil
+}
+
+// Close stops watching and closes all subscription channels. Safe to call
+// even if Start was never called.
+func (r *Registry) Close() error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.done != nil {
+		<-r.done
+	}
+
+	r.subsMu.Lock()
+	for _, ch := range r.subs {
+		close(ch)
+	}
+	r.subs = nil
+	r.subsMu.Unlock()
+
+	return nil
+}
+
+// watchDirs returns the set of directories to pass to fsnotify: for a
+// directory path loaded with WithRecursive, every subdirectory; otherwise
+// just the directory itself, or the parent directory of a single file or
+// glob pattern.
+func (r *Registry) watchDirs() []string {
+	loadOpts := r.resolvedLoadOptions()
+
+	seen := make(map[string]struct{})
+	var dirs []string
+	addDir := func(d string) {
+		if _, ok := seen[d]; !ok {
+			seen[d] = struct{}{}
+			dirs = append(dirs, d)
+		}
+	}
+
+	for _, p := range r.paths {
+		info, err := os.Stat(p)
+		switch {
+		case err != nil || !info.IsDir():
+			addDir(filepath.Dir(p))
+		case loadOpts.recursive:
+			_ = filepath.WalkDir(p, func(path string, d fs.DirEntry, walkErr error) error {
+				if walkErr != nil {
+					return nil //nolint:nilerr // best-effort: skip unreadable subdirectories rather than failing Start
+				}
+				if d.IsDir() {
+					addDir(path)
+				}
+				return nil
+			})
+		default:
+			addDir(p)
+		}
+	}
+
+	return dirs
+}
+
+func (r *Registry) resolvedLoadOptions() *loadOptions {
+	options := defaultLoadOptions()
+	for _, opt := range r.options.loadOpts {
+		opt(options)
+	}
+
+	return options
+}
+
+// watchLoop consumes fsnotify events until ctx is cancelled, debouncing
+// bursts before calling reload.
+func (r *Registry) watchLoop(ctx context.Context, watcher *fsnotify.Watcher) {
+	defer close(r.done)
+	defer func() { _ = watcher.Close() }()
+
+	timer := time.NewTimer(r.options.debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	armed := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			if armed {
+				timer.Stop()
+			}
+			return
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+			if armed && !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(r.options.debounce)
+			armed = true
+
+		case <-timer.C:
+			armed = false
+			_ = r.reload()
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			r.publish(RegistryEvent{Err: err})
+		}
+	}
+}
+
+// Reload re-resolves the Registry's paths and re-parses every file
+// immediately, rather than waiting for Start's fsnotify watch to notice a
+// change. Useful for an explicit "refresh now" action, or for tests that
+// don't want to wait out the debounce window.
+func (r *Registry) Reload() error {
+	return r.reload()
+}
+
+// reload re-resolves the Registry's paths and re-parses each file
+// individually, so one file that fails to parse doesn't block the rest of
+// the batch from updating, and its last-known-good entry (if any) is kept.
+// It returns the first parse error encountered, if any.
+func (r *Registry) reload() error {
+	options := r.resolvedLoadOptions()
+	mode := resolveMode(options)
+	warnHandler := resolveWarnHandler(options)
+
+	var files []string
+	for _, p := range r.paths {
+		resolved, err := resolvePath(p, options)
+		if err != nil {
+			r.publish(RegistryEvent{Path: p, Err: err})
+			continue
+		}
+		files = append(files, resolved...)
+	}
+
+	var firstErr error
+	for _, file := range files {
+		prompt, err := r.parseFileLocked(file, mode, warnHandler)
+		if err != nil {
+			r.publish(RegistryEvent{Path: file, Err: err})
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		key := r.options.keyFunc(prompt)
+
+		r.mu.Lock()
+		r.prompts[key] = prompt
+		r.mu.Unlock()
+
+		r.publish(RegistryEvent{Key: key, Path: file})
+	}
+
+	return firstErr
+}
+
+// parseFileLocked parses file, holding the "<path>.lock" advisory lock for
+// the duration of the read when WithFileLocking is set.
+func (r *Registry) parseFileLocked(file string, mode MetadataMode, warnHandler WarnHandler) (*Prompt, error) {
+	if !r.options.fileLocking {
+		return parseFile(file, mode, warnHandler)
+	}
+
+	unlock, err := lockPath(file)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return parseFile(file, mode, warnHandler)
+}
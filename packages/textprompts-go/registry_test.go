@@ -0,0 +1,220 @@
+package textprompts
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewRegistryAndGet(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-registry-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "greeting.txt")
+	if err := os.WriteFile(path, []byte("---\ntitle = \"Greeting\"\n---\nHello, {name}!"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	reg, err := NewRegistry([]string{tmpDir})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer func() { _ = reg.Close() }()
+
+	prompt, ok := reg.Get("Greeting")
+	if !ok {
+		t.Fatal("Get(\"Greeting\") not found")
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("body = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+
+	if keys := reg.Keys(); len(keys) != 1 || keys[0] != "Greeting" {
+		t.Errorf("Keys() = %v, want [Greeting]", keys)
+	}
+}
+
+func TestRegistryReloadPicksUpChanges(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-registry-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "greeting.txt")
+	if err := os.WriteFile(path, []byte("---\ntitle = \"Greeting\"\n---\nHello v1"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	reg, err := NewRegistry([]string{tmpDir})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer func() { _ = reg.Close() }()
+
+	events := reg.Subscribe()
+
+	if err := os.WriteFile(path, []byte("---\ntitle = \"Greeting\"\n---\nHello v2"), 0644); err != nil {
+		t.Fatalf("failed to rewrite prompt file: %v", err)
+	}
+
+	if err := reg.reload(); err != nil {
+		t.Fatalf("reload() error = %v", err)
+	}
+
+	prompt, ok := reg.Get("Greeting")
+	if !ok {
+		t.Fatal("Get(\"Greeting\") not found after reload")
+	}
+	if prompt.Prompt.String() != "Hello v2" {
+		t.Errorf("body after reload = %q, want %q", prompt.Prompt.String(), "Hello v2")
+	}
+
+	select {
+	case event := <-events:
+		if event.Err != nil {
+			t.Errorf("event.Err = %v, want nil", event.Err)
+		}
+		if event.Key != "Greeting" {
+			t.Errorf("event.Key = %q, want %q", event.Key, "Greeting")
+		}
+	case <-time.After(time.Second):
+		t.Error("timed out waiting for reload event")
+	}
+}
+
+func TestRegistryPublicReload(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-registry-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "greeting.txt")
+	if err := os.WriteFile(path, []byte("---\ntitle = \"Greeting\"\n---\nHello v1"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	reg, err := NewRegistry([]string{tmpDir})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer func() { _ = reg.Close() }()
+
+	if err := os.WriteFile(path, []byte("---\ntitle = \"Greeting\"\n---\nHello v2"), 0644); err != nil {
+		t.Fatalf("failed to rewrite prompt file: %v", err)
+	}
+
+	// Reload without ever calling Start: no fsnotify watch is running, so
+	// this is the only way to pick up the change.
+	if err := reg.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	prompt, ok := reg.Get("Greeting")
+	if !ok {
+		t.Fatal("Get(\"Greeting\") not found after Reload")
+	}
+	if prompt.Prompt.String() != "Hello v2" {
+		t.Errorf("body after Reload = %q, want %q", prompt.Prompt.String(), "Hello v2")
+	}
+}
+
+func TestRegistryFileLockingAgainstConcurrentWriter(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-registry-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "greeting.txt")
+	meta := PromptMeta{Title: StringPtr("Greeting")}
+	if err := SavePromptContent(path, meta, "Hello v0"); err != nil {
+		t.Fatalf("SavePromptContent() error = %v", err)
+	}
+
+	reg, err := NewRegistry([]string{tmpDir}, WithFileLocking())
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer func() { _ = reg.Close() }()
+
+	const iterations = 50
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			body := fmt.Sprintf("Hello v%d", i+1)
+			// WithAtomicWrite(false) writes the file in place rather than via
+			// temp-file-then-rename, so WithFileLocking's advisory lock is
+			// what keeps a concurrent reload from observing a half-written
+			// file, not the rename's own atomicity.
+			if err := SavePromptContent(path, meta, body, WithAtomicWrite(false)); err != nil {
+				t.Errorf("SavePromptContent() error = %v", err)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			if err := reg.Reload(); err != nil {
+				t.Errorf("Reload() error = %v", err)
+				return
+			}
+			if _, ok := reg.Get("Greeting"); !ok {
+				t.Error("Get(\"Greeting\") not found during concurrent writes")
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestRegistryKeepsLastGoodOnReloadFailure(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-registry-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "greeting.txt")
+	validContent := "---\ntitle = \"Greeting\"\nversion = \"1.0.0\"\ndescription = \"d\"\n---\nHello v1"
+	if err := os.WriteFile(path, []byte(validContent), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	reg, err := NewRegistry([]string{tmpDir}, WithRegistryLoadOptions(WithMetadataMode(ModeStrict)))
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	defer func() { _ = reg.Close() }()
+
+	// Break strict-mode validation by dropping required metadata.
+	brokenContent := "---\ntitle = \"Greeting\"\n---\nHello v2"
+	if err := os.WriteFile(path, []byte(brokenContent), 0644); err != nil {
+		t.Fatalf("failed to rewrite prompt file: %v", err)
+	}
+
+	if err := reg.reload(); err == nil {
+		t.Fatal("reload() error = nil, want a validation error")
+	}
+
+	prompt, ok := reg.Get("Greeting")
+	if !ok {
+		t.Fatal("Get(\"Greeting\") not found, last-known-good entry should survive a failed reload")
+	}
+	if prompt.Prompt.String() != "Hello v1" {
+		t.Errorf("body = %q, want last-known-good %q", prompt.Prompt.String(), "Hello v1")
+	}
+}
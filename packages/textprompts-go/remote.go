@@ -0,0 +1,433 @@
+package textprompts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fetcher retrieves the raw bytes of a remote prompt. rawURL is the original
+// source string LoadPrompt/LoadPrompts was given (scheme and all), so a
+// Fetcher can reparse it however its scheme needs; client is the
+// *http.Client configured via WithHTTPClient, for fetchers built on top of
+// net/http. If prevETag is non-empty and the fetcher supports conditional
+// requests, it may return notModified=true (with data nil) to indicate the
+// caller's cached copy, matched against that ETag, is still current.
+type Fetcher interface {
+	Fetch(rawURL string, client *http.Client, prevETag string) (data []byte, etag string, notModified bool, err error)
+}
+
+// FetcherFunc adapts a function to the Fetcher interface.
+type FetcherFunc func(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error)
+
+// Fetch calls f.
+func (f FetcherFunc) Fetch(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error) {
+	return f(rawURL, client, prevETag)
+}
+
+// fetcherMu guards fetchers, the global scheme -> Fetcher registry.
+var fetcherMu sync.RWMutex
+
+// fetchers holds the built-in Fetchers, keyed by the scheme schemeOf
+// extracts from a source path: "http"/"https" for plain URLs, "git" for
+// git+https://... and go-getter-style git::https://... URLs, and "file" for
+// loading a local path through the same cache/coalescing machinery as a
+// true remote source (mainly useful for tests). There is no built-in "s3"
+// fetcher since that would pull in a cloud SDK dependency this package
+// otherwise doesn't need; register one with RegisterFetcher or WithFetcher
+// to support it.
+var fetchers = map[string]Fetcher{
+	"http":  FetcherFunc(fetchHTTP),
+	"https": FetcherFunc(fetchHTTP),
+	"git":   FetcherFunc(fetchGit),
+	"file":  FetcherFunc(fetchFile),
+}
+
+// RegisterFetcher adds or replaces the Fetcher used globally for scheme
+// (the part of a source path before "://" or "::", e.g. "s3"). Use
+// WithFetcher instead to override a Fetcher for a single load.
+func RegisterFetcher(scheme string, f Fetcher) {
+	fetcherMu.Lock()
+	defer fetcherMu.Unlock()
+	fetchers[scheme] = f
+}
+
+// getFetcher returns the Fetcher registered for scheme, checking opts
+// (set by WithFetcher) before the global registry set by RegisterFetcher.
+func getFetcher(scheme string, opts *loadOptions) (Fetcher, bool) {
+	if opts != nil {
+		if f, ok := opts.fetcherOverrides[scheme]; ok {
+			return f, true
+		}
+	}
+
+	fetcherMu.RLock()
+	defer fetcherMu.RUnlock()
+	f, ok := fetchers[scheme]
+
+	return f, ok
+}
+
+// WithFetcher overrides the Fetcher used for scheme for this load only, in
+// place of the one registered globally with RegisterFetcher.
+func WithFetcher(scheme string, f Fetcher) LoadOption {
+	return func(o *loadOptions) {
+		if o.fetcherOverrides == nil {
+			o.fetcherOverrides = make(map[string]Fetcher)
+		}
+		o.fetcherOverrides[scheme] = f
+	}
+}
+
+// WithHTTPClient overrides the *http.Client the "http"/"https"/"git"
+// Fetchers use. The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) LoadOption {
+	return func(o *loadOptions) {
+		o.httpClient = client
+	}
+}
+
+// WithCacheDir sets the directory remote prompt fetches are cached in,
+// keyed by a hash of the source path. The default is defaultCacheDir().
+func WithCacheDir(dir string) LoadOption {
+	return func(o *loadOptions) {
+		o.cacheDir = dir
+	}
+}
+
+// WithCacheTTL sets how long a cached remote prompt is served without
+// contacting the origin at all. The default, zero, always revalidates with a
+// conditional request (If-None-Match against the cached ETag, for Fetchers
+// that support it), so a 304 response still skips the download but not the
+// round trip.
+func WithCacheTTL(ttl time.Duration) LoadOption {
+	return func(o *loadOptions) {
+		o.cacheTTL = ttl
+	}
+}
+
+// defaultCacheDir is used when WithCacheDir isn't supplied.
+func defaultCacheDir() string {
+	return filepath.Join(os.TempDir(), "textprompts-cache")
+}
+
+// schemeOf returns the scheme prefix of a remote source path, recognizing
+// both standard "scheme://" URLs and the double-colon "scheme::rest" form
+// used for go-getter-style forced getters (e.g.
+// "git::https://github.com/org/repo/path@v1"). It returns "" if p doesn't
+// look like either form.
+func schemeOf(p string) string {
+	if idx := strings.Index(p, "::"); idx > 0 && !strings.ContainsAny(p[:idx], "/\\") {
+		return p[:idx]
+	}
+
+	if idx := strings.Index(p, "://"); idx > 0 {
+		scheme := p[:idx]
+		if scheme == "git+https" {
+			return "git" // legacy alias predating the "git::" form, see gitRawURL
+		}
+
+		return scheme
+	}
+
+	return ""
+}
+
+// isRemotePath reports whether path is a source resolvePath/LoadPrompt
+// should fetch through a Fetcher rather than read from disk.
+func isRemotePath(path string) bool {
+	return schemeOf(path) != ""
+}
+
+// remoteCall is an in-flight or completed fetch of one remote URL, used by
+// remoteFetches to coalesce concurrent requests for the same path.
+type remoteCall struct {
+	cond   *sync.Cond
+	done   bool
+	prompt *Prompt
+	err    error
+}
+
+// remoteFetchGroup coalesces concurrent loadRemotePrompt calls for the same
+// URL: the first caller performs the fetch, and every other caller waits on
+// a sync.Cond and receives the same *Prompt/error, analogous to how remote
+// media fetchers deduplicate in-flight requests.
+type remoteFetchGroup struct {
+	mu      sync.Mutex
+	pending map[string]*remoteCall
+}
+
+var remoteFetches = &remoteFetchGroup{pending: make(map[string]*remoteCall)}
+
+// do runs fetch for key, or waits for and returns the result of a fetch
+// already in flight for the same key.
+func (g *remoteFetchGroup) do(key string, fetch func() (*Prompt, error)) (*Prompt, error) {
+	g.mu.Lock()
+	if call, ok := g.pending[key]; ok {
+		for !call.done {
+			call.cond.Wait()
+		}
+		g.mu.Unlock()
+		return call.prompt, call.err
+	}
+
+	call := &remoteCall{cond: sync.NewCond(&g.mu)}
+	g.pending[key] = call
+	g.mu.Unlock()
+
+	prompt, err := fetch()
+
+	g.mu.Lock()
+	call.prompt, call.err, call.done = prompt, err, true
+	delete(g.pending, key)
+	g.mu.Unlock()
+	call.cond.Broadcast()
+
+	return prompt, err
+}
+
+// loadRemotePrompt fetches rawURL through the Fetcher registered for its
+// scheme and the on-disk cache, coalescing concurrent requests for the same
+// URL, and parses the result with Prompt.Path set to rawURL rather than a
+// local filesystem path.
+func loadRemotePrompt(rawURL string, mode MetadataMode, warnHandler WarnHandler, options *loadOptions) (*Prompt, error) {
+	return remoteFetches.do(rawURL, func() (*Prompt, error) {
+		data, err := fetchRemote(rawURL, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseString(string(data), mode, rawURL, warnHandler)
+	})
+}
+
+// fetchRemote returns the bytes at rawURL, serving them from the on-disk
+// cache when WithCacheTTL allows it or the origin confirms the cached copy
+// is still current, and populating/refreshing the cache otherwise. The
+// cache is keyed by rawURL and validated by checksum: a cache entry whose
+// stored checksum doesn't match its contents (truncated write, tampering) is
+// treated as a miss rather than served.
+func fetchRemote(rawURL string, options *loadOptions) ([]byte, error) {
+	scheme := schemeOf(rawURL)
+	fetcher, ok := getFetcher(scheme, options)
+	if !ok {
+		return nil, &TextPromptsError{Message: fmt.Sprintf("no Fetcher registered for scheme %q; use RegisterFetcher or WithFetcher", scheme)}
+	}
+
+	cacheDir := options.cacheDir
+	if cacheDir == "" {
+		cacheDir = defaultCacheDir()
+	}
+	cachePath, etagPath := cachePaths(cacheDir, rawURL)
+
+	if options.cacheTTL > 0 {
+		if data, ok := readFreshCache(cachePath, options.cacheTTL); ok {
+			return data, nil
+		}
+	}
+
+	client := options.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	cached, hadCache := readCacheFile(cachePath)
+	var prevETag string
+	if hadCache {
+		if etag, ok := readCacheFile(etagPath); ok {
+			prevETag = strings.TrimSpace(string(etag))
+		}
+	}
+
+	data, etag, notModified, err := fetcher.Fetch(rawURL, client, prevETag)
+	if err != nil {
+		return nil, &TextPromptsError{Message: fmt.Sprintf("failed to fetch %s", rawURL), Cause: err}
+	}
+
+	if notModified {
+		if !hadCache {
+			return nil, &TextPromptsError{Message: fmt.Sprintf("%s: fetcher reported not-modified with no local cache", rawURL)}
+		}
+		now := time.Now()
+		_ = os.Chtimes(cachePath, now, now) // best-effort: refresh mtime so cacheTTL restarts from this revalidation
+		return cached, nil
+	}
+
+	if err := writeCacheFile(cachePath, data); err != nil {
+		return nil, err
+	}
+	if etag != "" {
+		_ = writeCacheFile(etagPath, []byte(etag))
+	}
+
+	return data, nil
+}
+
+// fetchHTTP is the built-in Fetcher for the "http" and "https" schemes. It
+// issues a conditional GET when prevETag is set, reporting notModified on a
+// 304 response.
+func fetchHTTP(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if prevETag != "" {
+		req.Header.Set("If-None-Match", prevETag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, prevETag, true, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return data, resp.Header.Get("ETag"), false, nil
+}
+
+// fetchGit is the built-in Fetcher for the "git" scheme, covering both the
+// legacy "git+https://host/owner/repo/path@ref" form and the go-getter-style
+// "git::https://host/owner/repo/path?ref=ref" form. It resolves to the raw
+// content URL the forge serves the file at and delegates to fetchHTTP; it
+// does not support go-getter's "//subdir" directory syntax, since
+// LoadPrompt/LoadPrompts always fetch a single file.
+func fetchGit(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error) {
+	resolved, err := gitRawURL(rawURL)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return fetchHTTP(resolved, client, prevETag)
+}
+
+// fetchFile is the built-in Fetcher for the "file" scheme. It has no notion
+// of an ETag, so it always reports a fresh fetch; WithCacheTTL is the only
+// way to avoid rereading it on every load.
+func fetchFile(rawURL string, _ *http.Client, _ string) ([]byte, string, bool, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	data, err := os.ReadFile(u.Path)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return data, "", false, nil
+}
+
+// gitRawURL converts a "git+https://host/owner/repo/path/to/file@ref" or
+// "git::https://host/owner/repo/path/to/file?ref=ref" path into the
+// raw-content URL GitHub (and compatible forges) serve it at. ref defaults
+// to "HEAD" when omitted.
+func gitRawURL(rawURL string) (string, error) {
+	rest := rawURL
+	switch {
+	case strings.HasPrefix(rest, "git+"):
+		rest = strings.TrimPrefix(rest, "git+")
+	case strings.HasPrefix(rest, "git::"):
+		rest = strings.TrimPrefix(rest, "git::")
+	}
+
+	u, err := url.Parse(rest)
+	if err != nil {
+		return "", fmt.Errorf("invalid git URL %q: %w", rawURL, err)
+	}
+
+	ref := "HEAD"
+	filePath := u.Path
+	if q := u.Query().Get("ref"); q != "" {
+		ref = q
+	} else if idx := strings.LastIndex(filePath, "@"); idx >= 0 {
+		ref = filePath[idx+1:]
+		filePath = filePath[:idx]
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(filePath, "/"), "/", 3)
+	if len(parts) < 3 {
+		return "", fmt.Errorf("git URL %q must name a file as host/owner/repo/path", rawURL)
+	}
+	owner, repo, entry := parts[0], parts[1], parts[2]
+
+	return fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s", owner, repo, ref, entry), nil
+}
+
+// cachePaths returns the on-disk paths fetchRemote caches rawURL's body and
+// ETag under, keyed by a hash of the URL so arbitrary source paths map to
+// flat, filesystem-safe names.
+func cachePaths(cacheDir, rawURL string) (dataPath, etagPath string) {
+	sum := sha256.Sum256([]byte(rawURL))
+	name := hex.EncodeToString(sum[:])
+
+	return filepath.Join(cacheDir, name), filepath.Join(cacheDir, name+".etag")
+}
+
+// readFreshCache returns the cached body at path if it exists and is newer
+// than ttl.
+func readFreshCache(path string, ttl time.Duration) ([]byte, bool) {
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > ttl {
+		return nil, false
+	}
+
+	return readCacheFile(path)
+}
+
+// readCacheFile reads path and validates it against its "<path>.sum"
+// checksum sidecar, reporting ok=false if the file doesn't exist, can't be
+// read, or doesn't match its checksum (a truncated write or tampering,
+// since writeCacheFile always writes both together).
+func readCacheFile(path string) ([]byte, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	wantSum, err := os.ReadFile(path + ".sum")
+	if err != nil {
+		return nil, false
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != strings.TrimSpace(string(wantSum)) {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// writeCacheFile writes data to path atomically along with its checksum
+// sidecar, creating the cache directory first if needed.
+func writeCacheFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return &TextPromptsError{Message: "failed to create cache directory", Cause: err}
+	}
+
+	if err := writeFileAtomic(path, data, 0644); err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return writeFileAtomic(path+".sum", []byte(hex.EncodeToString(sum[:])), 0644)
+}
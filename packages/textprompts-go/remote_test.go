@@ -0,0 +1,252 @@
+package textprompts
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadPromptRemote(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("Hello, {name}!"))
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+
+	prompt, err := LoadPrompt(srv.URL+"/greeting.txt", WithCacheDir(cacheDir))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("LoadPrompt() body = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+	if prompt.Path != srv.URL+"/greeting.txt" {
+		t.Errorf("LoadPrompt() path = %q, want the source URL", prompt.Path)
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Fatalf("server hits = %d, want 1", hits)
+	}
+}
+
+func TestLoadPromptRemoteRevalidation(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("Hello, {name}!"))
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+
+	if _, err := LoadPrompt(srv.URL+"/greeting.txt", WithCacheDir(cacheDir)); err != nil {
+		t.Fatalf("LoadPrompt() first load error = %v", err)
+	}
+
+	prompt, err := LoadPrompt(srv.URL+"/greeting.txt", WithCacheDir(cacheDir))
+	if err != nil {
+		t.Fatalf("LoadPrompt() second load error = %v", err)
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("LoadPrompt() body after 304 = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+	if atomic.LoadInt32(&hits) != 2 {
+		t.Fatalf("server hits = %d, want 2 (fetch + revalidate)", hits)
+	}
+}
+
+func TestLoadPromptRemoteCacheTTL(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte("Hello, {name}!"))
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+
+	for i := 0; i < 3; i++ {
+		if _, err := LoadPrompt(srv.URL+"/greeting.txt", WithCacheDir(cacheDir), WithCacheTTL(time.Hour)); err != nil {
+			t.Fatalf("LoadPrompt() iteration %d error = %v", i, err)
+		}
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Fatalf("server hits = %d, want 1 (later loads served from cache within TTL)", hits)
+	}
+}
+
+func TestIsRemotePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"http://example.com/p.txt", true},
+		{"https://example.com/p.txt", true},
+		{"git+https://github.com/acme/prompts/greeting.txt@main", true},
+		{"prompts/greeting.txt", false},
+		{"/abs/path/greeting.txt", false},
+	}
+	for _, tt := range tests {
+		if got := isRemotePath(tt.path); got != tt.want {
+			t.Errorf("isRemotePath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestGitRawURL(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"legacy @ref form", "git+https://github.com/acme/prompts/greeting.txt@v1.2.0",
+			"https://raw.githubusercontent.com/acme/prompts/v1.2.0/greeting.txt"},
+		{"go-getter ?ref= form", "git::https://github.com/acme/prompts/greeting.txt?ref=v1.2.0",
+			"https://raw.githubusercontent.com/acme/prompts/v1.2.0/greeting.txt"},
+		{"no ref defaults to HEAD", "git+https://github.com/acme/prompts/greeting.txt",
+			"https://raw.githubusercontent.com/acme/prompts/HEAD/greeting.txt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gitRawURL(tt.in)
+			if err != nil {
+				t.Fatalf("gitRawURL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("gitRawURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := gitRawURL("git+https://github.com/acme@main"); err == nil {
+		t.Error("gitRawURL() with too few path segments: want error, got nil")
+	}
+}
+
+func TestLoadPromptGitScheme(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/acme/prompts/v1/greeting.txt" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+		}
+		_, _ = w.Write([]byte("Hello, {name}!"))
+	}))
+	defer srv.Close()
+
+	RegisterFetcher("git", FetcherFunc(func(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error) {
+		resolved, err := gitRawURL(rawURL)
+		if err != nil {
+			return nil, "", false, err
+		}
+		// Redirect raw.githubusercontent.com to our test server.
+		resolved = srv.URL + strings.TrimPrefix(resolved, "https://raw.githubusercontent.com")
+		return fetchHTTP(resolved, client, prevETag)
+	}))
+	t.Cleanup(func() { RegisterFetcher("git", FetcherFunc(fetchGit)) })
+
+	prompt, err := LoadPrompt("git::https://github.com/acme/prompts/greeting.txt?ref=v1", WithCacheDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("LoadPrompt() body = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+}
+
+func TestLoadPromptFileScheme(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "greeting.txt")
+	if err := os.WriteFile(path, []byte("Hello, {name}!"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	prompt, err := LoadPrompt("file://"+path, WithCacheDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("LoadPrompt() body = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+}
+
+func TestWithFetcherOverridesScheme(t *testing.T) {
+	var called bool
+	custom := FetcherFunc(func(rawURL string, client *http.Client, prevETag string) ([]byte, string, bool, error) {
+		called = true
+		return []byte("Hello, {name}!"), "", false, nil
+	})
+
+	prompt, err := LoadPrompt("s3://bucket/greeting.txt", WithFetcher("s3", custom), WithCacheDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+	if !called {
+		t.Error("WithFetcher's Fetcher was not invoked")
+	}
+	if prompt.Prompt.String() != "Hello, {name}!" {
+		t.Errorf("LoadPrompt() body = %q, want %q", prompt.Prompt.String(), "Hello, {name}!")
+	}
+}
+
+func TestLoadPromptUnregisteredScheme(t *testing.T) {
+	_, err := LoadPrompt("s3://bucket/greeting.txt", WithCacheDir(t.TempDir()))
+	if err == nil {
+		t.Fatal("LoadPrompt() with an unregistered scheme: want error, got nil")
+	}
+}
+
+func TestSchemeOf(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"http://example.com/p.txt", "http"},
+		{"https://example.com/p.txt", "https"},
+		{"git+https://github.com/acme/prompts/greeting.txt@main", "git"},
+		{"git::https://github.com/acme/prompts/greeting.txt?ref=main", "git"},
+		{"file:///tmp/p.txt", "file"},
+		{"prompts/greeting.txt", ""},
+		{"/abs/path/greeting.txt", ""},
+	}
+	for _, tt := range tests {
+		if got := schemeOf(tt.path); got != tt.want {
+			t.Errorf("schemeOf(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCacheFilesAreWrittenUnderCacheDir(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("Hello, {name}!"))
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+
+	if _, err := LoadPrompt(srv.URL+"/greeting.txt", WithCacheDir(cacheDir)); err != nil {
+		t.Fatalf("LoadPrompt() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("ReadDir(cacheDir) error = %v", err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("expected LoadPrompt to populate the cache directory")
+	}
+	if filepath.IsAbs(entries[0].Name()) {
+		t.Errorf("cache entry name %q should be a flat filename, not a path", entries[0].Name())
+	}
+}
@@ -4,56 +4,205 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/BurntSushi/toml"
 )
 
-// SavePrompt writes a prompt to a file with TOML frontmatter.
-func SavePrompt(path string, prompt *Prompt) error {
-	content, err := formatPromptContent(prompt.Meta, prompt.Prompt.String())
+// Writer abstracts the filesystem operations SavePrompt needs, letting
+// non-OS backends (in-memory stores used by tests, afero, S3 wrappers, etc.)
+// plug in without SavePrompt touching disk directly.
+type Writer interface {
+	MkdirAll(path string, perm os.FileMode) error
+	WriteFile(path string, data []byte, perm os.FileMode) error
+}
+
+// defaultWriter is the default Writer, backed directly by the os package.
+// Its WriteFile is safe under concurrent writers: it takes a per-path lock
+// (see pathMutex) and, unless atomic is false, writes to a sibling temp file
+// and renames it into place instead of writing the target directly.
+type defaultWriter struct {
+	atomic bool
+}
+
+func (w defaultWriter) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+func (w defaultWriter) WriteFile(path string, data []byte, perm os.FileMode) error {
+	unlock, err := lockPath(path)
 	if err != nil {
 		return err
 	}
+	defer unlock()
+
+	if !w.atomic {
+		return os.WriteFile(path, data, perm)
+	}
+
+	return writeFileAtomic(path, data, perm)
+}
+
+// pathMutex holds one *sync.Mutex per canonicalized absolute path, so that
+// concurrent SavePrompt calls targeting the same file from goroutines in
+// this binary serialize. This is necessary in addition to the cross-process
+// flock/LockFileEx taken in lockPath: OS file locks aren't visible to the Go
+// race detector or compiler, so they don't synchronize goroutines by
+// themselves.
+var pathMutex sync.Map // map[string]*sync.Mutex
+
+func mutexForPath(path string) *sync.Mutex {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+
+	actual, _ := pathMutex.LoadOrStore(abs, &sync.Mutex{})
+
+	return actual.(*sync.Mutex)
+}
+
+// lockPath acquires the in-process mutex for path plus a cross-process
+// advisory lock on a "<path>.lock" sidecar file, and returns a function that
+// releases both. Callers must defer the returned function.
+func lockPath(path string) (func(), error) {
+	mu := mutexForPath(path)
+	mu.Lock()
+
+	lockFilePath := path + ".lock"
+	if err := os.MkdirAll(filepath.Dir(lockFilePath), 0755); err != nil {
+		mu.Unlock()
+		return nil, &TextPromptsError{Message: "failed to create directory", Cause: err}
+	}
 
-	// Ensure directory exists
+	lf, err := os.OpenFile(lockFilePath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		mu.Unlock()
+		return nil, &TextPromptsError{Message: "failed to open lock file", Cause: err}
+	}
+
+	if err := lockFile(lf); err != nil {
+		_ = lf.Close()
+		mu.Unlock()
+		return nil, &TextPromptsError{Message: "failed to acquire file lock", Cause: err}
+	}
+
+	return func() {
+		_ = unlockFile(lf)
+		_ = lf.Close()
+		mu.Unlock()
+	}, nil
+}
+
+// writeFileAtomic writes data to a temp file alongside path, fsyncs it, and
+// renames it over path so readers never observe a partially written file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return &TextPromptsError{
-			Message: "failed to create directory",
-			Cause:   err,
-		}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return &TextPromptsError{Message: "failed to create temp file", Cause: err}
 	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }() // no-op once the rename below succeeds
 
-	// Write file
-	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
-		return &TextPromptsError{
-			Message: "failed to write file",
-			Cause:   err,
-		}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return &TextPromptsError{Message: "failed to write temp file", Cause: err}
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return &TextPromptsError{Message: "failed to sync temp file", Cause: err}
+	}
+	if err := tmp.Close(); err != nil {
+		return &TextPromptsError{Message: "failed to close temp file", Cause: err}
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return &TextPromptsError{Message: "failed to set file permissions", Cause: err}
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return &TextPromptsError{Message: "failed to replace file", Cause: err}
 	}
 
 	return nil
 }
 
+// saveOptions holds configuration for SavePrompt/SavePromptContent.
+type saveOptions struct {
+	writer Writer
+}
+
+func defaultSaveOptions() *saveOptions {
+	return &saveOptions{writer: defaultWriter{atomic: true}}
+}
+
+// SaveOption configures saving behavior.
+type SaveOption func(*saveOptions)
+
+// WithWriter overrides the Writer used to create directories and write the
+// file, in place of the OS filesystem.
+func WithWriter(w Writer) SaveOption {
+	return func(o *saveOptions) {
+		o.writer = w
+	}
+}
+
+// WithAtomicWrite controls whether saves go through the temp-file-then-rename
+// path (the default). Disabling it writes the target in place, trading
+// crash-safety for speed: a crash mid-write leaves a truncated file, and a
+// reader that doesn't take the "<path>.lock" lock can observe a partial
+// write. The lock itself is still taken, so concurrent saves of the same
+// path still serialize. This may be worth it for performance-sensitive bulk
+// imports into a directory nothing else is reading concurrently.
+// It only affects the default OS-backed Writer; a Writer set with WithWriter
+// is responsible for its own atomicity.
+func WithAtomicWrite(enabled bool) SaveOption {
+	return func(o *saveOptions) {
+		if dw, ok := o.writer.(defaultWriter); ok {
+			dw.atomic = enabled
+			o.writer = dw
+		}
+	}
+}
+
+// SavePrompt writes a prompt to a file with TOML frontmatter.
+func SavePrompt(path string, prompt *Prompt, opts ...SaveOption) error {
+	content, err := formatPromptContent(prompt.Meta, prompt.Prompt.String())
+	if err != nil {
+		return err
+	}
+
+	return writePromptFile(path, content, opts...)
+}
+
 // SavePromptContent writes prompt content with metadata to a file.
-func SavePromptContent(path string, meta PromptMeta, content string) error {
+func SavePromptContent(path string, meta PromptMeta, content string, opts ...SaveOption) error {
 	formatted, err := formatPromptContent(meta, content)
 	if err != nil {
 		return err
 	}
 
-	// Ensure directory exists
+	return writePromptFile(path, formatted, opts...)
+}
+
+// writePromptFile ensures path's directory exists and writes content to it
+// using the configured Writer.
+func writePromptFile(path, content string, opts ...SaveOption) error {
+	options := defaultSaveOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := options.writer.MkdirAll(dir, 0755); err != nil {
 		return &TextPromptsError{
 			Message: "failed to create directory",
 			Cause:   err,
 		}
 	}
 
-	// Write file
-	if err := os.WriteFile(path, []byte(formatted), 0644); err != nil {
+	if err := options.writer.WriteFile(path, []byte(content), 0644); err != nil {
 		return &TextPromptsError{
 			Message: "failed to write file",
 			Cause:   err,
@@ -87,6 +236,9 @@ func formatPromptContent(meta PromptMeta, content string) (string, error) {
 		if meta.Description != nil && *meta.Description != "" {
 			metaMap["description"] = *meta.Description
 		}
+		if meta.Locale != nil && *meta.Locale != "" {
+			metaMap["locale"] = *meta.Locale
+		}
 		if meta.Created != nil {
 			// Format as date only (YYYY-MM-DD) for TOML
 			metaMap["created"] = meta.Created.Time.Format("2006-01-02")
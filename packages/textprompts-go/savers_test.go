@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -114,6 +115,27 @@ func TestSavePrompt(t *testing.T) {
 			t.Errorf("Body = %q, want %q", loaded.Prompt.String(), "Hello {user}!")
 		}
 	})
+
+	t.Run("roundtrip with locale", func(t *testing.T) {
+		original := NewPrompt(
+			PromptMeta{Title: StringPtr("Greeting"), Locale: StringPtr("fr-FR")},
+			"Bonjour {name}!",
+		)
+
+		path := filepath.Join(tmpDir, "roundtrip_locale.txt")
+		if err := SavePrompt(path, original); err != nil {
+			t.Fatalf("SavePrompt() error = %v", err)
+		}
+
+		loaded, err := LoadPrompt(path, WithMetadataMode(ModeAllow))
+		if err != nil {
+			t.Fatalf("LoadPrompt() error = %v", err)
+		}
+
+		if loaded.Meta.GetLocale() != "fr-FR" {
+			t.Errorf("Locale = %q, want %q", loaded.Meta.GetLocale(), "fr-FR")
+		}
+	})
 }
 
 func TestSavePromptContent(t *testing.T) {
@@ -147,6 +169,83 @@ func TestSavePromptContent(t *testing.T) {
 	}
 }
 
+func TestSavePromptConcurrent(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	path := filepath.Join(tmpDir, "concurrent.txt")
+
+	const writers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			prompt := NewPromptWithTitle("Concurrent", strings.Repeat("x", n+1))
+			errs <- SavePrompt(path, prompt)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("SavePrompt() error = %v", err)
+		}
+	}
+
+	// The file must be one of the complete writes, never a torn mix of two.
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read saved file: %v", err)
+	}
+	if !strings.Contains(string(content), "---") {
+		t.Error("Saved file should still contain complete frontmatter, not a torn write")
+	}
+
+	if _, err := os.Stat(path + ".lock"); err != nil {
+		t.Errorf("expected lock sidecar file to exist, stat error = %v", err)
+	}
+}
+
+func TestSavePromptWithAtomicWriteDisabled(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "textprompts-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	prompt := NewPromptWithTitle("Test", "Content")
+	path := filepath.Join(tmpDir, "non_atomic.txt")
+
+	if err := SavePrompt(path, prompt, WithAtomicWrite(false)); err != nil {
+		t.Fatalf("SavePrompt() error = %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read saved file: %v", err)
+	}
+	if !strings.Contains(string(content), "Content") {
+		t.Error("Saved file should contain body")
+	}
+
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to read temp dir: %v", err)
+	}
+	for _, e := range entries {
+		if strings.Contains(e.Name(), ".tmp-") {
+			t.Errorf("WithAtomicWrite(false) should not leave temp files behind, found %q", e.Name())
+		}
+	}
+}
+
 func TestNewPrompt(t *testing.T) {
 	meta := PromptMeta{
 		Title: StringPtr("Test"),
@@ -203,3 +302,39 @@ func TestNewPromptFull(t *testing.T) {
 		t.Errorf("Content = %q, want %q", prompt.Prompt.String(), "Full content")
 	}
 }
+
+// memWriter is an in-memory Writer used to test that SavePrompt can target a
+// non-OS backend.
+type memWriter struct {
+	files map[string][]byte
+}
+
+func (w *memWriter) MkdirAll(path string, perm os.FileMode) error {
+	return nil
+}
+
+func (w *memWriter) WriteFile(path string, data []byte, perm os.FileMode) error {
+	if w.files == nil {
+		w.files = make(map[string][]byte)
+	}
+	w.files[path] = data
+
+	return nil
+}
+
+func TestSavePromptWithWriter(t *testing.T) {
+	mem := &memWriter{}
+	prompt := NewPromptWithTitle("Greeting", "Hello {name}!")
+
+	if err := SavePrompt("virtual/greeting.txt", prompt, WithWriter(mem)); err != nil {
+		t.Fatalf("SavePrompt() error = %v", err)
+	}
+
+	data, ok := mem.files["virtual/greeting.txt"]
+	if !ok {
+		t.Fatal("SavePrompt() did not write through the custom Writer")
+	}
+	if !strings.Contains(string(data), "Hello {name}!") {
+		t.Errorf("written content = %q, want it to contain the prompt body", string(data))
+	}
+}
@@ -0,0 +1,48 @@
+package textprompts
+
+import "fmt"
+
+// SymlinkPolicy controls how LoadPrompts handles symbolic links found while
+// walking a directory.
+type SymlinkPolicy int
+
+const (
+	// SymlinkFollow follows symlinks as if they were the files/directories
+	// they point to (DEFAULT, matches historical behavior). Recursive walks
+	// still detect and stop at symlink cycles.
+	SymlinkFollow SymlinkPolicy = iota
+	// SymlinkSkip ignores any symlink encountered under the root, following
+	// neither symlinked files nor symlinked directories.
+	SymlinkSkip
+	// SymlinkErrorPolicy aborts the walk with a SymlinkError as soon as a
+	// symlink is encountered under the root.
+	SymlinkErrorPolicy
+)
+
+// String returns the string representation of the policy.
+func (p SymlinkPolicy) String() string {
+	switch p {
+	case SymlinkFollow:
+		return "follow"
+	case SymlinkSkip:
+		return "skip"
+	case SymlinkErrorPolicy:
+		return "error"
+	default:
+		return fmt.Sprintf("SymlinkPolicy(%d)", p)
+	}
+}
+
+// WithSymlinks sets the policy LoadPrompts applies to symlinks found while
+// walking a directory. The default, SymlinkFollow, matches historical
+// behavior: LoadPrompts followed symlinks before SymlinkPolicy existed, and
+// an earlier draft of this option considered defaulting to SymlinkSkip
+// instead, but that would silently change what existing callers load, so the
+// default stayed SymlinkFollow and skip/error are opt-in instead. Use
+// SymlinkSkip or SymlinkErrorPolicy when loading from shared or mounted
+// directories where a rogue symlink could pull in arbitrary files.
+func WithSymlinks(policy SymlinkPolicy) LoadOption {
+	return func(o *loadOptions) {
+		o.symlinkPolicy = policy
+	}
+}
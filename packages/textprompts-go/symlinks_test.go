@@ -0,0 +1,233 @@
+package textprompts
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestLoadPromptsSymlinkFollow(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	realDir := filepath.Join(tmpDir, "real")
+	if err := os.Mkdir(realDir, 0755); err != nil {
+		t.Fatalf("failed to create real dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(realDir, "a.txt"), []byte("Hello A"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	linkDir := filepath.Join(tmpDir, "root")
+	if err := os.Mkdir(linkDir, 0755); err != nil {
+		t.Fatalf("failed to create root dir: %v", err)
+	}
+	if err := os.Symlink(realDir, filepath.Join(linkDir, "link")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	prompts, err := LoadPrompts([]string{linkDir}, WithRecursive())
+	if err != nil {
+		t.Fatalf("LoadPrompts() error = %v", err)
+	}
+	if len(prompts) != 1 {
+		t.Fatalf("got %d prompts, want 1", len(prompts))
+	}
+}
+
+func TestLoadPromptsSymlinkSkip(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "target.txt"), []byte("Hello target"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	linkDir := filepath.Join(tmpDir, "root")
+	if err := os.Mkdir(linkDir, 0755); err != nil {
+		t.Fatalf("failed to create root dir: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(tmpDir, "target.txt"), filepath.Join(linkDir, "link.txt")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	prompts, err := LoadPrompts([]string{linkDir}, WithSymlinks(SymlinkSkip))
+	if err != nil {
+		t.Fatalf("LoadPrompts() error = %v", err)
+	}
+	if len(prompts) != 0 {
+		t.Fatalf("got %d prompts, want 0 (symlink should be skipped)", len(prompts))
+	}
+}
+
+func TestLoadPromptsSymlinkError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "target.txt"), []byte("Hello target"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	linkDir := filepath.Join(tmpDir, "root")
+	if err := os.Mkdir(linkDir, 0755); err != nil {
+		t.Fatalf("failed to create root dir: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(tmpDir, "target.txt"), filepath.Join(linkDir, "link.txt")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	_, err = LoadPrompts([]string{linkDir}, WithSymlinks(SymlinkErrorPolicy))
+	if !IsSymlinkError(err) {
+		t.Fatalf("LoadPrompts() error = %v, want a SymlinkError", err)
+	}
+}
+
+func TestLoadPromptsSymlinkCycle(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "a.txt"), []byte("Hello A"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+	if err := os.Symlink(tmpDir, filepath.Join(tmpDir, "cycle")); err != nil {
+		t.Fatalf("failed to create symlink cycle: %v", err)
+	}
+
+	prompts, err := LoadPrompts([]string{tmpDir}, WithRecursive())
+	if err != nil {
+		t.Fatalf("LoadPrompts() error = %v", err)
+	}
+	if len(prompts) != 1 {
+		t.Fatalf("got %d prompts, want 1 (cycle should not be walked twice)", len(prompts))
+	}
+}
+
+func TestLoadPromptsSymlinkTwoNodeCycle(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	aDir := filepath.Join(tmpDir, "a")
+	bDir := filepath.Join(tmpDir, "b")
+	if err := os.Mkdir(aDir, 0755); err != nil {
+		t.Fatalf("failed to create dir a: %v", err)
+	}
+	if err := os.Mkdir(bDir, 0755); err != nil {
+		t.Fatalf("failed to create dir b: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(aDir, "in_a.txt"), []byte("Hello A"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(bDir, "in_b.txt"), []byte("Hello B"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+	// a -> b, b -> a: a two-node cycle, as opposed to the single-directory
+	// self-link cycle TestLoadPromptsSymlinkCycle exercises.
+	if err := os.Symlink(bDir, filepath.Join(aDir, "to_b")); err != nil {
+		t.Fatalf("failed to create symlink a->b: %v", err)
+	}
+	if err := os.Symlink(aDir, filepath.Join(bDir, "to_a")); err != nil {
+		t.Fatalf("failed to create symlink b->a: %v", err)
+	}
+
+	prompts, err := LoadPrompts([]string{aDir}, WithRecursive())
+	if err != nil {
+		t.Fatalf("LoadPrompts() error = %v", err)
+	}
+	if len(prompts) != 2 {
+		t.Fatalf("got %d prompts, want 2 (in_a.txt and in_b.txt, cycle not walked twice)", len(prompts))
+	}
+}
+
+func TestLoadPromptsSymlinkAndTargetDeduplicated(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	target := filepath.Join(tmpDir, "target.txt")
+	if err := os.WriteFile(target, []byte("Hello target"), 0644); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+	link := filepath.Join(tmpDir, "link.txt")
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	prompts, err := LoadPrompts([]string{target, link})
+	if err != nil {
+		t.Fatalf("LoadPrompts() error = %v", err)
+	}
+	if len(prompts) != 1 {
+		t.Fatalf("got %d prompts, want 1 (symlink and its target are the same file)", len(prompts))
+	}
+}
+
+func TestLoadPromptsDanglingSymlink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlink creation requires elevated privileges on windows")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "textprompts-symlink-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	if err := os.Symlink(filepath.Join(tmpDir, "missing"), filepath.Join(tmpDir, "dangling")); err != nil {
+		t.Fatalf("failed to create dangling symlink: %v", err)
+	}
+
+	_, err = LoadPrompts([]string{tmpDir})
+	if !IsDanglingSymlink(err) {
+		t.Fatalf("LoadPrompts() error = %v, want a DanglingSymlinkError", err)
+	}
+
+	prompts, err := LoadPrompts([]string{tmpDir}, WithSymlinks(SymlinkSkip))
+	if err != nil {
+		t.Fatalf("LoadPrompts() with SymlinkSkip error = %v", err)
+	}
+	if len(prompts) != 0 {
+		t.Fatalf("got %d prompts, want 0 (dangling symlink skipped)", len(prompts))
+	}
+}
@@ -0,0 +1,96 @@
+package textprompts
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// WarnSeverity classifies a WarnEvent.
+type WarnSeverity int
+
+const (
+	// WarnInfo marks a warning about expected, non-harmful behavior (e.g.
+	// metadata present but ignored because of the active MetadataMode).
+	WarnInfo WarnSeverity = iota
+	// WarnIssue marks a warning about metadata that is present but could not
+	// be fully honored (e.g. invalid in a non-strict mode).
+	WarnIssue
+)
+
+// String returns the string representation of the severity.
+func (s WarnSeverity) String() string {
+	switch s {
+	case WarnInfo:
+		return "info"
+	case WarnIssue:
+		return "issue"
+	default:
+		return fmt.Sprintf("WarnSeverity(%d)", s)
+	}
+}
+
+// WarnEvent describes a single warning raised while loading a prompt.
+type WarnEvent struct {
+	// Path is the source file path, or "" when loading from a string.
+	Path string
+	// Mode is the MetadataMode active when the warning was raised.
+	Mode MetadataMode
+	// Fields names the metadata fields the warning concerns.
+	Fields []string
+	// Severity classifies the warning.
+	Severity WarnSeverity
+	// Message is a human-readable description of the warning.
+	Message string
+}
+
+// WarnHandler receives WarnEvents as they're raised. Handlers must be safe
+// for concurrent use, since prompts may be loaded from multiple goroutines.
+type WarnHandler func(event WarnEvent)
+
+// DiscardWarnings is a WarnHandler that drops every event. Use it with
+// SetWarnHandler or WithWarnHandler to silence warnings entirely.
+func DiscardWarnings(WarnEvent) {}
+
+// defaultWarnHandler writes a single line to os.Stderr per event, preserving
+// the library's historical behavior.
+func defaultWarnHandler(event WarnEvent) {
+	fmt.Fprintf(os.Stderr, "textprompts: %s: %s (mode=%s, fields=%s, path=%s)\n",
+		event.Severity, event.Message, event.Mode, strings.Join(event.Fields, ", "), event.Path)
+}
+
+var (
+	globalWarnHandler   WarnHandler = defaultWarnHandler
+	globalWarnHandlerMu sync.RWMutex
+)
+
+// SetWarnHandler sets the global WarnHandler used when a LoadOption doesn't
+// override it with WithWarnHandler. Passing nil restores the default
+// stderr-writing handler.
+func SetWarnHandler(h WarnHandler) {
+	globalWarnHandlerMu.Lock()
+	defer globalWarnHandlerMu.Unlock()
+
+	if h == nil {
+		h = defaultWarnHandler
+	}
+	globalWarnHandler = h
+}
+
+// GetWarnHandler returns the current global WarnHandler.
+func GetWarnHandler() WarnHandler {
+	globalWarnHandlerMu.RLock()
+	defer globalWarnHandlerMu.RUnlock()
+
+	return globalWarnHandler
+}
+
+// emitWarn invokes handler with event, unless warnings are disabled via
+// SetWarnOnIgnoredMetadata(false).
+func emitWarn(handler WarnHandler, event WarnEvent) {
+	if !WarnOnIgnoredMetadata() || handler == nil {
+		return
+	}
+	handler(event)
+}
@@ -0,0 +1,90 @@
+package textprompts
+
+import (
+	"testing"
+)
+
+func TestWithWarnHandlerCapturesIgnoredMetadata(t *testing.T) {
+	original := WarnOnIgnoredMetadata()
+	defer SetWarnOnIgnoredMetadata(original)
+	SetWarnOnIgnoredMetadata(true)
+
+	var events []WarnEvent
+	handler := func(e WarnEvent) { events = append(events, e) }
+
+	content := "---\ntitle = \"Greeting\"\n---\nHello, {name}!"
+	prompt, err := FromString(content, WithMetadataMode(ModeIgnore), WithWarnHandler(handler))
+	if err != nil {
+		t.Fatalf("FromString() error = %v", err)
+	}
+	if prompt.Prompt.String() != content {
+		t.Errorf("ModeIgnore should keep the whole file as body, got %q", prompt.Prompt.String())
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("got %d warn events, want 1", len(events))
+	}
+	if events[0].Mode != ModeIgnore {
+		t.Errorf("event.Mode = %v, want %v", events[0].Mode, ModeIgnore)
+	}
+	if events[0].Severity != WarnInfo {
+		t.Errorf("event.Severity = %v, want %v", events[0].Severity, WarnInfo)
+	}
+}
+
+func TestWithWarnHandlerSuppressedByFlag(t *testing.T) {
+	original := WarnOnIgnoredMetadata()
+	defer SetWarnOnIgnoredMetadata(original)
+	SetWarnOnIgnoredMetadata(false)
+
+	var events []WarnEvent
+	handler := func(e WarnEvent) { events = append(events, e) }
+
+	content := "---\ntitle = \"Greeting\"\n---\nHello, {name}!"
+	if _, err := FromString(content, WithMetadataMode(ModeIgnore), WithWarnHandler(handler)); err != nil {
+		t.Fatalf("FromString() error = %v", err)
+	}
+
+	if len(events) != 0 {
+		t.Errorf("got %d warn events, want 0 when WarnOnIgnoredMetadata is false", len(events))
+	}
+}
+
+func TestDiscardWarnings(t *testing.T) {
+	original := GetWarnHandler()
+	defer SetWarnHandler(original)
+	SetWarnHandler(DiscardWarnings)
+
+	content := "---\ntitle = \"Greeting\"\n---\nHello, {name}!"
+	if _, err := FromString(content, WithMetadataMode(ModeIgnore)); err != nil {
+		t.Fatalf("FromString() error = %v", err)
+	}
+	// No assertion beyond "it didn't panic or error" - DiscardWarnings just drops events.
+}
+
+func TestSetWarnHandlerNilRestoresDefault(t *testing.T) {
+	original := GetWarnHandler()
+	defer SetWarnHandler(original)
+
+	SetWarnHandler(DiscardWarnings)
+	SetWarnHandler(nil)
+
+	if got := GetWarnHandler(); got == nil {
+		t.Error("GetWarnHandler() returned nil after SetWarnHandler(nil)")
+	}
+}
+
+func TestWarnSeverityString(t *testing.T) {
+	tests := []struct {
+		severity WarnSeverity
+		want     string
+	}{
+		{WarnInfo, "info"},
+		{WarnIssue, "issue"},
+	}
+	for _, tt := range tests {
+		if got := tt.severity.String(); got != tt.want {
+			t.Errorf("WarnSeverity(%d).String() = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}